@@ -0,0 +1,128 @@
+package elcontracts
+
+import (
+	"context"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// RewardsMerkleClaim is a convenience alias for the generated struct, so
+// callers of this package don't need to import the bindings package
+// directly.
+type RewardsMerkleClaim = contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+
+// defaultMaxClaimsPerTx caps how many claims a single ProcessClaims call
+// batches together, so a large batch doesn't exceed the block gas limit.
+const defaultMaxClaimsPerTx = 20
+
+// claimsTransactor is the subset of RewardsCoordinator write methods needed
+// to submit a batch of claims.
+type claimsTransactor interface {
+	ProcessClaims(opts *bind.TransactOpts, claims []RewardsMerkleClaim, recipient common.Address) (*types.Transaction, error)
+}
+
+// BatchClaimSubmitter groups many RewardsMerkleClaim values - potentially
+// spanning multiple earners and tokens - into as few ProcessClaims calls as
+// possible, estimating gas per chunk so a caller can decide up front
+// whether a chunk will fit their gas budget.
+type BatchClaimSubmitter struct {
+	transactor     claimsTransactor
+	ethClient      gasEstimator
+	maxClaimsPerTx int
+	rewardsAddress common.Address
+	logger         logging.Logger
+}
+
+// NewBatchClaimSubmitter returns a BatchClaimSubmitter backed by
+// transactor. If maxClaimsPerTx is zero, defaultMaxClaimsPerTx is used.
+func NewBatchClaimSubmitter(
+	transactor claimsTransactor,
+	ethClient gasEstimator,
+	rewardsAddress common.Address,
+	maxClaimsPerTx int,
+	logger logging.Logger,
+) *BatchClaimSubmitter {
+	if maxClaimsPerTx == 0 {
+		maxClaimsPerTx = defaultMaxClaimsPerTx
+	}
+	return &BatchClaimSubmitter{
+		transactor:     transactor,
+		ethClient:      ethClient,
+		maxClaimsPerTx: maxClaimsPerTx,
+		rewardsAddress: rewardsAddress,
+		logger:         logger,
+	}
+}
+
+// chunk splits claims into groups no larger than MaxClaimsPerTx.
+func (s *BatchClaimSubmitter) chunk(claims []RewardsMerkleClaim) [][]RewardsMerkleClaim {
+	var chunks [][]RewardsMerkleClaim
+	for len(claims) > 0 {
+		n := s.maxClaimsPerTx
+		if n > len(claims) {
+			n = len(claims)
+		}
+		chunks = append(chunks, claims[:n])
+		claims = claims[n:]
+	}
+	return chunks
+}
+
+// EstimateChunks returns the gas estimate for each chunk ProcessClaims
+// would be split into for claims, without sending anything. Callers can use
+// this to reject a batch up front if it would exceed a gas budget.
+func (s *BatchClaimSubmitter) EstimateChunks(
+	ctx context.Context,
+	from common.Address,
+	recipient common.Address,
+	claims []RewardsMerkleClaim,
+) ([]uint64, error) {
+	parsedABI, err := contractIRewardsCoordinator.ContractIRewardsCoordinatorMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing RewardsCoordinator ABI: %w", err)
+	}
+
+	estimates := make([]uint64, 0)
+	for _, chunk := range s.chunk(claims) {
+		calldata, err := parsedABI.Pack("processClaims", chunk, recipient)
+		if err != nil {
+			return estimates, fmt.Errorf("error encoding ProcessClaims calldata: %w", err)
+		}
+		gas, err := s.ethClient.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &s.rewardsAddress, Data: calldata})
+		if err != nil {
+			return estimates, fmt.Errorf("error estimating ProcessClaims gas: %w", err)
+		}
+		estimates = append(estimates, gas)
+	}
+	return estimates, nil
+}
+
+// SubmitAll sends claims to ProcessClaims in MaxClaimsPerTx-sized chunks,
+// returning one receipt-bearing transaction per chunk.
+func (s *BatchClaimSubmitter) SubmitAll(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	recipient common.Address,
+	claims []RewardsMerkleClaim,
+) ([]*types.Transaction, error) {
+	if len(claims) == 0 {
+		return nil, fmt.Errorf("no claims provided")
+	}
+	var txs []*types.Transaction
+	for _, chunk := range s.chunk(claims) {
+		tx, err := s.transactor.ProcessClaims(opts, chunk, recipient)
+		if err != nil {
+			return txs, fmt.Errorf("error submitting chunk of %d claims: %w", len(chunk), err)
+		}
+		s.logger.Info("Submitted batch claim chunk", "count", len(chunk), "recipient", recipient, "txHash", tx.Hash())
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
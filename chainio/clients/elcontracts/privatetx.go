@@ -0,0 +1,67 @@
+package elcontracts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// PrivateTxSender submits a signed, raw transaction through a private relay
+// (e.g. a Flashbots Protect / MEV-Blocker style RPC endpoint) instead of the
+// public mempool, which some permissioned RewardsCoordinator deployments
+// require so that e.g. split changes or large claim batches aren't
+// front-runnable or publicly visible before inclusion.
+type PrivateTxSender interface {
+	SendPrivateTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// PrivateTransactOpts wraps a *bind.TransactOpts so that calls made through
+// it are signed locally but routed to sender instead of being broadcast by
+// the normal bind.ContractBackend, by setting NoSend and intercepting the
+// signed transaction.
+//
+// Usage mirrors any other TransactOpts: pass the embedded TransactOpts of
+// the result of NewPrivateTransactOpts to a generated binding's writer
+// methods exactly as you would opts itself; the binding signs the call
+// without sending it, and the caller then passes the returned transaction
+// to Submit, which forwards it to sender.
+type PrivateTransactOpts struct {
+	*bind.TransactOpts
+	sender PrivateTxSender
+	logger logging.Logger
+
+	ctx context.Context
+	tx  *types.Transaction
+}
+
+// NewPrivateTransactOpts returns a PrivateTransactOpts derived from opts:
+// transactions signed through it are sent to sender instead of being
+// broadcast by the contract backend. opts.NoSend is forced to true.
+func NewPrivateTransactOpts(ctx context.Context, opts *bind.TransactOpts, sender PrivateTxSender, logger logging.Logger) *PrivateTransactOpts {
+	wrapped := *opts
+	wrapped.NoSend = true
+	return &PrivateTransactOpts{TransactOpts: &wrapped, sender: sender, logger: logger, ctx: ctx}
+}
+
+// Submit sends tx (as returned by a generated binding's writer method
+// called with p.TransactOpts) to the configured private relay. Callers must
+// call Submit themselves after the writer call returns, since
+// bind.ContractBackend has no hook for "signed but not yet sent".
+func (p *PrivateTransactOpts) Submit(tx *types.Transaction) error {
+	p.tx = tx
+	if err := p.sender.SendPrivateTransaction(p.ctx, tx); err != nil {
+		return fmt.Errorf("error sending transaction %s to private relay: %w", tx.Hash(), err)
+	}
+	p.logger.Info("Submitted transaction via private relay", "txHash", tx.Hash())
+	return nil
+}
+
+// LastTx returns the most recently Submit-ed transaction, or nil if none
+// has been submitted yet.
+func (p *PrivateTransactOpts) LastTx() *types.Transaction {
+	return p.tx
+}
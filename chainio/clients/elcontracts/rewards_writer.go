@@ -0,0 +1,296 @@
+// Package elcontracts holds high-level writers/readers around the
+// EigenLayer core contract bindings that need more than a thin pass-through
+// of the generated methods: batching, validation, and gas/allowance
+// bookkeeping that every caller would otherwise have to reimplement.
+package elcontracts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// defaultMaxSubmissionsPerTx caps how many OperatorDirectedRewardsSubmission
+// entries are sent in a single CreateOperatorDirectedAVSRewardsSubmission
+// call so that a large batch doesn't exceed the block gas limit. Callers can
+// override it via RewardsWriterConfig.
+const defaultMaxSubmissionsPerTx = 25
+
+// OperatorDirectedRewardsSubmission is a convenience alias for the
+// generated struct, so callers of this package don't need to import the
+// bindings package directly.
+type OperatorDirectedRewardsSubmission = contractIRewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission
+
+// rewardsCoordinatorCaller is the subset of read methods needed to validate
+// a submission before sending it.
+type rewardsCoordinatorCaller interface {
+	CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error)
+	MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error)
+}
+
+// rewardsCoordinatorTransactor is the subset of write methods needed to
+// submit operator-directed rewards.
+type rewardsCoordinatorTransactor interface {
+	CreateOperatorDirectedAVSRewardsSubmission(
+		opts *bind.TransactOpts,
+		avs common.Address,
+		submissions []OperatorDirectedRewardsSubmission,
+	) (*types.Transaction, error)
+}
+
+// erc20Caller/erc20Transactor are the subset of a generated ERC20 binding's
+// methods needed to check and raise allowances before submitting rewards.
+type erc20Caller interface {
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+}
+
+type erc20Transactor interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// RewardsWriterConfig customizes SubmitOperatorDirectedRewards' batching
+// behavior.
+type RewardsWriterConfig struct {
+	// MaxSubmissionsPerTx caps how many submissions are sent per
+	// CreateOperatorDirectedAVSRewardsSubmission call. Zero uses
+	// defaultMaxSubmissionsPerTx.
+	MaxSubmissionsPerTx int
+}
+
+// RewardsWriter submits OperatorDirectedRewardsSubmissions to the
+// RewardsCoordinator, validating timing invariants, sorting strategies, and
+// topping up token allowances as needed.
+type RewardsWriter struct {
+	rewardsCoordinator rewardsCoordinatorCaller
+	rewardsTransactor  rewardsCoordinatorTransactor
+	rewardsAddress     common.Address
+	newERC20           func(token common.Address) (erc20Caller, erc20Transactor, error)
+	ethClient          gasEstimator
+	logger             logging.Logger
+	config             RewardsWriterConfig
+}
+
+// NewRewardsWriter returns a RewardsWriter that reads timing constants from
+// rewardsCoordinator, submits through rewardsTransactor, and looks up ERC20
+// bindings for allowance management via newERC20.
+func NewRewardsWriter(
+	rewardsCoordinator rewardsCoordinatorCaller,
+	rewardsTransactor rewardsCoordinatorTransactor,
+	rewardsAddress common.Address,
+	newERC20 func(token common.Address) (erc20Caller, erc20Transactor, error),
+	ethClient gasEstimator,
+	logger logging.Logger,
+	config RewardsWriterConfig,
+) *RewardsWriter {
+	if config.MaxSubmissionsPerTx == 0 {
+		config.MaxSubmissionsPerTx = defaultMaxSubmissionsPerTx
+	}
+	return &RewardsWriter{
+		rewardsCoordinator: rewardsCoordinator,
+		rewardsTransactor:  rewardsTransactor,
+		rewardsAddress:     rewardsAddress,
+		newERC20:           newERC20,
+		ethClient:          ethClient,
+		logger:             logger,
+		config:             config,
+	}
+}
+
+// validateTiming checks StartTimestamp/Duration against the on-chain
+// CALCULATION_INTERVAL_SECONDS and MAX_REWARDS_DURATION invariants the
+// RewardsCoordinator enforces, so a bad submission fails locally instead of
+// burning gas on a revert.
+func (w *RewardsWriter) validateTiming(ctx context.Context, s OperatorDirectedRewardsSubmission) error {
+	interval, err := w.rewardsCoordinator.CALCULATIONINTERVALSECONDS(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("error reading CALCULATION_INTERVAL_SECONDS: %w", err)
+	}
+	if s.StartTimestamp%interval != 0 {
+		return fmt.Errorf("StartTimestamp %d is not a multiple of CALCULATION_INTERVAL_SECONDS %d", s.StartTimestamp, interval)
+	}
+	if s.Duration%interval != 0 {
+		return fmt.Errorf("Duration %d is not a multiple of CALCULATION_INTERVAL_SECONDS %d", s.Duration, interval)
+	}
+	maxDuration, err := w.rewardsCoordinator.MAXREWARDSDURATION(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("error reading MAX_REWARDS_DURATION: %w", err)
+	}
+	if s.Duration > maxDuration {
+		return fmt.Errorf("Duration %d exceeds MAX_REWARDS_DURATION %d", s.Duration, maxDuration)
+	}
+	return nil
+}
+
+// sortStrategies orders StrategiesAndMultipliers by strategy address, which
+// the RewardsCoordinator requires to be strictly increasing.
+func sortStrategies(s *OperatorDirectedRewardsSubmission) {
+	sort.Slice(s.StrategiesAndMultipliers, func(i, j int) bool {
+		return bytes.Compare(
+			s.StrategiesAndMultipliers[i].Strategy.Bytes(),
+			s.StrategiesAndMultipliers[j].Strategy.Bytes(),
+		) < 0
+	})
+}
+
+// totalAmount sums every OperatorReward.Amount in the submission, i.e. the
+// allowance the RewardsCoordinator needs to pull.
+func totalAmount(s OperatorDirectedRewardsSubmission) *big.Int {
+	total := new(big.Int)
+	for _, r := range s.OperatorRewards {
+		total.Add(total, r.Amount)
+	}
+	return total
+}
+
+// ensureAllowance tops up the submitter's ERC20 allowance to the
+// RewardsCoordinator if it's below need, issuing an approve transaction
+// when necessary.
+func (w *RewardsWriter) ensureAllowance(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	token common.Address,
+	owner common.Address,
+	need *big.Int,
+) error {
+	caller, transactor, err := w.newERC20(token)
+	if err != nil {
+		return fmt.Errorf("error getting ERC20 binding for token %s: %w", token, err)
+	}
+	allowance, err := caller.Allowance(&bind.CallOpts{Context: ctx}, owner, w.rewardsAddress)
+	if err != nil {
+		return fmt.Errorf("error reading allowance for token %s: %w", token, err)
+	}
+	if allowance.Cmp(need) >= 0 {
+		return nil
+	}
+	w.logger.Info("Approving RewardsCoordinator to spend token", "token", token, "amount", need)
+	tx, err := transactor.Approve(opts, w.rewardsAddress, need)
+	if err != nil {
+		return fmt.Errorf("error approving token %s: %w", token, err)
+	}
+	w.logger.Debug("Submitted approve transaction", "token", token, "txHash", tx.Hash())
+	return nil
+}
+
+// chunkSubmissions splits submissions into groups no larger than
+// MaxSubmissionsPerTx.
+func (w *RewardsWriter) chunkSubmissions(submissions []OperatorDirectedRewardsSubmission) [][]OperatorDirectedRewardsSubmission {
+	var chunks [][]OperatorDirectedRewardsSubmission
+	for len(submissions) > 0 {
+		n := w.config.MaxSubmissionsPerTx
+		if n > len(submissions) {
+			n = len(submissions)
+		}
+		chunks = append(chunks, submissions[:n])
+		submissions = submissions[n:]
+	}
+	return chunks
+}
+
+// SubmitOperatorDirectedRewards validates, sorts, and approves as needed,
+// then submits submissions to the RewardsCoordinator for avs, splitting
+// into multiple CreateOperatorDirectedAVSRewardsSubmission calls if needed
+// to stay under MaxSubmissionsPerTx. It returns one transaction per chunk
+// sent and does not wait for their receipts.
+func (w *RewardsWriter) SubmitOperatorDirectedRewards(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	avs common.Address,
+	submissions []OperatorDirectedRewardsSubmission,
+) ([]*types.Transaction, error) {
+	if len(submissions) == 0 {
+		return nil, fmt.Errorf("no submissions provided")
+	}
+
+	for i := range submissions {
+		if err := w.validateTiming(ctx, submissions[i]); err != nil {
+			return nil, fmt.Errorf("submission %d invalid: %w", i, err)
+		}
+		sortStrategies(&submissions[i])
+		if err := w.ensureAllowance(ctx, opts, submissions[i].Token, opts.From, totalAmount(submissions[i])); err != nil {
+			return nil, fmt.Errorf("submission %d: %w", i, err)
+		}
+	}
+
+	var txs []*types.Transaction
+	for _, chunk := range w.chunkSubmissions(submissions) {
+		tx, err := w.rewardsTransactor.CreateOperatorDirectedAVSRewardsSubmission(opts, avs, chunk)
+		if err != nil {
+			return txs, fmt.Errorf("error submitting chunk of %d operator-directed rewards: %w", len(chunk), err)
+		}
+		w.logger.Info("Submitted operator-directed rewards chunk", "avs", avs, "count", len(chunk), "txHash", tx.Hash())
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// gasEstimator is the subset of bind.ContractBackend needed to estimate gas
+// for a call without sending it.
+type gasEstimator interface {
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
+// DryRunResult is the encoded calldata and estimated gas for a submission
+// that was validated and packed but not sent, useful for multisig/Safe
+// flows that need calldata ahead of time.
+type DryRunResult struct {
+	To       common.Address
+	Calldata []byte
+	GasLimit uint64
+}
+
+// DryRun validates and sorts submissions exactly as SubmitOperatorDirectedRewards
+// does, then returns the ABI-encoded calldata for
+// CreateOperatorDirectedAVSRewardsSubmission along with an estimated gas
+// limit, without broadcasting anything. Submissions must already fit within
+// MaxSubmissionsPerTx; callers that want chunking should call
+// chunkSubmissions themselves and DryRun each chunk.
+func (w *RewardsWriter) DryRun(
+	ctx context.Context,
+	from common.Address,
+	avs common.Address,
+	submissions []OperatorDirectedRewardsSubmission,
+) (DryRunResult, error) {
+	if len(submissions) == 0 {
+		return DryRunResult{}, fmt.Errorf("no submissions provided")
+	}
+	if len(submissions) > w.config.MaxSubmissionsPerTx {
+		return DryRunResult{}, fmt.Errorf("%d submissions exceeds MaxSubmissionsPerTx %d; chunk before calling DryRun", len(submissions), w.config.MaxSubmissionsPerTx)
+	}
+
+	for i := range submissions {
+		if err := w.validateTiming(ctx, submissions[i]); err != nil {
+			return DryRunResult{}, fmt.Errorf("submission %d invalid: %w", i, err)
+		}
+		sortStrategies(&submissions[i])
+	}
+
+	parsedABI, err := contractIRewardsCoordinator.ContractIRewardsCoordinatorMetaData.GetAbi()
+	if err != nil {
+		return DryRunResult{}, fmt.Errorf("error parsing RewardsCoordinator ABI: %w", err)
+	}
+	calldata, err := parsedABI.Pack("createOperatorDirectedAVSRewardsSubmission", avs, submissions)
+	if err != nil {
+		return DryRunResult{}, fmt.Errorf("error encoding calldata: %w", err)
+	}
+
+	result := DryRunResult{To: w.rewardsAddress, Calldata: calldata}
+	if w.ethClient != nil {
+		gas, err := w.ethClient.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &w.rewardsAddress, Data: calldata})
+		if err != nil {
+			return result, fmt.Errorf("error estimating gas: %w", err)
+		}
+		result.GasLimit = gas
+	}
+	return result, nil
+}
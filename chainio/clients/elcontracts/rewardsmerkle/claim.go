@@ -0,0 +1,145 @@
+package rewardsmerkle
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// Earning is a single (token, cumulativeEarnings) pair for one earner, as
+// read from an off-chain rewards calculation.
+type Earning struct {
+	Token              common.Address
+	CumulativeEarnings *big.Int
+}
+
+// TokenLeafHash reproduces the contract's
+// keccak256(abi.encodePacked(token, cumulativeEarnings)) leaf hash.
+func TokenLeafHash(leaf contractIRewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf) [32]byte {
+	buf := make([]byte, 0, common.AddressLength+32)
+	buf = append(buf, leaf.Token.Bytes()...)
+	buf = append(buf, common.LeftPadBytes(leaf.CumulativeEarnings.Bytes(), 32)...)
+	return crypto.Keccak256Hash(buf)
+}
+
+// EarnerLeafHash reproduces the contract's
+// keccak256(abi.encodePacked(earner, earnerTokenRoot)) leaf hash.
+func EarnerLeafHash(leaf contractIRewardsCoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf) [32]byte {
+	buf := make([]byte, 0, common.AddressLength+32)
+	buf = append(buf, leaf.Earner.Bytes()...)
+	buf = append(buf, leaf.EarnerTokenRoot[:]...)
+	return crypto.Keccak256Hash(buf)
+}
+
+func tokenTree(earnings []Earning) (*Tree, []contractIRewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf, error) {
+	sorted := append([]Earning(nil), earnings...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Token.Bytes(), sorted[j].Token.Bytes()) < 0
+	})
+	leaves := make([]contractIRewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf, len(sorted))
+	hashes := make([][32]byte, len(sorted))
+	for i, e := range sorted {
+		leaves[i] = contractIRewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf{
+			Token:              e.Token,
+			CumulativeEarnings: e.CumulativeEarnings,
+		}
+		hashes[i] = TokenLeafHash(leaves[i])
+	}
+	tree, err := BuildTree(hashes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tree, leaves, nil
+}
+
+// ClaimTree is the full two-level RewardsCoordinator tree: an earner tree
+// whose leaves commit to each earner's own token-tree root.
+type ClaimTree struct {
+	earners     []common.Address
+	earnerTree  *Tree
+	tokenTrees  map[common.Address]*Tree
+	tokenLeaves map[common.Address][]contractIRewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf
+}
+
+// BuildClaimTree constructs the two-level tree for entries, keyed by earner
+// address. Earners are ordered by address to produce a deterministic tree
+// across independent builders computing the same root.
+func BuildClaimTree(entries map[common.Address][]Earning) (*ClaimTree, error) {
+	if len(entries) == 0 {
+		return nil, ErrEmptyTree
+	}
+	earners := make([]common.Address, 0, len(entries))
+	for e := range entries {
+		earners = append(earners, e)
+	}
+	sort.Slice(earners, func(i, j int) bool {
+		return bytes.Compare(earners[i].Bytes(), earners[j].Bytes()) < 0
+	})
+
+	earnerHashes := make([][32]byte, len(earners))
+	tokenTrees := make(map[common.Address]*Tree, len(earners))
+	tokenLeaves := make(map[common.Address][]contractIRewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf, len(earners))
+	for i, earner := range earners {
+		tt, leaves, err := tokenTree(entries[earner])
+		if err != nil {
+			return nil, fmt.Errorf("error building token tree for earner %s: %w", earner, err)
+		}
+		tokenTrees[earner] = tt
+		tokenLeaves[earner] = leaves
+		earnerHashes[i] = EarnerLeafHash(contractIRewardsCoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf{
+			Earner:          earner,
+			EarnerTokenRoot: tt.Root(),
+		})
+	}
+	earnerTree, err := BuildTree(earnerHashes)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimTree{earners: earners, earnerTree: earnerTree, tokenTrees: tokenTrees, tokenLeaves: tokenLeaves}, nil
+}
+
+// Root returns the earner tree root, i.e. the DistributionRoot posted via
+// SubmitRoot.
+func (t *ClaimTree) Root() [32]byte {
+	return t.earnerTree.Root()
+}
+
+// ProveEarner returns a claim with EarnerIndex/EarnerTreeProof/EarnerLeaf
+// and TokenIndices/TokenTreeProofs/TokenLeaves populated for earner.
+// RootIndex is left zero; callers fill it in from
+// GetRootIndexFromHash(Root()).
+func (t *ClaimTree) ProveEarner(earner common.Address) (contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, error) {
+	idx := sort.Search(len(t.earners), func(i int) bool {
+		return bytes.Compare(t.earners[i].Bytes(), earner.Bytes()) >= 0
+	})
+	if idx >= len(t.earners) || t.earners[idx] != earner {
+		return contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{}, fmt.Errorf("earner %s not found in tree", earner)
+	}
+
+	leaves := t.tokenLeaves[earner]
+	tt := t.tokenTrees[earner]
+	tokenIndices := make([]uint32, len(leaves))
+	tokenProofs := make([][]byte, len(leaves))
+	for i := range leaves {
+		tokenIndices[i] = uint32(i)
+		tokenProofs[i] = tt.Prove(i)
+	}
+
+	return contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{
+		EarnerIndex:     uint32(idx),
+		EarnerTreeProof: t.earnerTree.Prove(idx),
+		EarnerLeaf: contractIRewardsCoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf{
+			Earner:          earner,
+			EarnerTokenRoot: tt.Root(),
+		},
+		TokenIndices:    tokenIndices,
+		TokenTreeProofs: tokenProofs,
+		TokenLeaves:     leaves,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package rewardsmerkle
+
+// StreamBuilder accumulates leaf hashes one at a time and folds them into
+// intermediate nodes as soon as a pair is complete, so building a tree over
+// millions of earners never holds more than O(log n) hashes in memory
+// (versus BuildTree, which keeps every leaf). It trades that memory bound
+// for not being able to produce proofs: callers that need a proof for a
+// specific leaf should use BuildTree (or re-run StreamBuilder and keep the
+// leaves for the earners they need to prove).
+type StreamBuilder struct {
+	// levels[i] holds at most one pending node at height i, waiting for
+	// its sibling to arrive.
+	levels []*[32]byte
+	count  int
+}
+
+// NewStreamBuilder returns an empty StreamBuilder.
+func NewStreamBuilder() *StreamBuilder {
+	return &StreamBuilder{}
+}
+
+// Add folds in the next leaf hash, in order. Leaves must be added in the
+// same order BuildTree would have received them for the resulting root to
+// match.
+func (b *StreamBuilder) Add(leaf [32]byte) {
+	b.count++
+	node := leaf
+	for level := 0; ; level++ {
+		if level == len(b.levels) {
+			b.levels = append(b.levels, nil)
+		}
+		if b.levels[level] == nil {
+			b.levels[level] = &node
+			return
+		}
+		node = HashNodes(*b.levels[level], node)
+		b.levels[level] = nil
+	}
+}
+
+// Root finalizes the tree and returns its root. Root pads any
+// odd-sized partial layer by duplicating its last node, matching
+// BuildTree's convention; it can be called only once a StreamBuilder has
+// the same leaf count and order that would be passed to BuildTree.
+func (b *StreamBuilder) Root() ([32]byte, error) {
+	if b.count == 0 {
+		return [32]byte{}, ErrEmptyTree
+	}
+	var node *[32]byte
+	nodeLevel := 0
+	for level := 0; level < len(b.levels); level++ {
+		if b.levels[level] == nil {
+			continue
+		}
+		if node == nil {
+			node = b.levels[level]
+			nodeLevel = level
+			continue
+		}
+		// node is the last node of each layer below level, so it is
+		// paired with a copy of itself until it reaches level.
+		for ; nodeLevel < level; nodeLevel++ {
+			padded := HashNodes(*node, *node)
+			node = &padded
+		}
+		combined := HashNodes(*b.levels[level], *node)
+		node = &combined
+		nodeLevel = level + 1
+	}
+	// A single leaf is its own root.
+	if node == nil {
+		node = b.levels[0]
+	}
+	return *node, nil
+}
+
+// Count returns the number of leaves added so far.
+func (b *StreamBuilder) Count() int {
+	return b.count
+}
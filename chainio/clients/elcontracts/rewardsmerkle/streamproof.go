@@ -0,0 +1,141 @@
+package rewardsmerkle
+
+import "fmt"
+
+// LeafSource yields tree leaves in the fixed order a tree was (or will be)
+// built over. Implementations back this with whatever millions-of-earners
+// data source produced the leaves - a sorted DB cursor, a file, etc. - so
+// that proof generation never needs the whole leaf set resident in memory.
+type LeafSource interface {
+	// Next returns the next leaf hash and true, or a zero value and
+	// false once exhausted.
+	Next() ([32]byte, bool, error)
+}
+
+// StreamProver builds the proof for one target leaf index in a single pass
+// over a LeafSource, using the same O(log n) per-level accumulator as
+// StreamBuilder: at most one pending node and, once the target's ancestor
+// at that level is known, one recorded sibling hash per level. It never
+// holds more than a tree's depth worth of state, regardless of leaf count.
+type StreamProver struct {
+	targetIndex int
+
+	// pending[level] is the node waiting for its sibling at that level.
+	pending []*[32]byte
+	// isTarget[level] is true if pending[level] lies on the path from
+	// targetIndex to the root.
+	isTarget []bool
+
+	proof    [][32]byte
+	leafSeen bool
+	target   [32]byte
+}
+
+// NewStreamProver returns a StreamProver that will compute the proof for
+// the leaf at targetIndex.
+func NewStreamProver(targetIndex int) *StreamProver {
+	return &StreamProver{targetIndex: targetIndex}
+}
+
+// add folds node in at level, recursing up on every completed pair exactly
+// as StreamBuilder.Add does, while tracking whether the target's ancestor
+// passes through this call and recording the sibling hash when it does.
+func (p *StreamProver) add(level int, node [32]byte, isTarget bool) {
+	for {
+		if level == len(p.pending) {
+			p.pending = append(p.pending, nil)
+			p.isTarget = append(p.isTarget, false)
+		}
+		if isTarget {
+			p.target = node
+		}
+
+		if p.pending[level] == nil {
+			p.pending[level] = &node
+			p.isTarget[level] = isTarget
+			return
+		}
+
+		left := *p.pending[level]
+		leftIsTarget := p.isTarget[level]
+		p.pending[level] = nil
+		p.isTarget[level] = false
+
+		if leftIsTarget {
+			p.setProof(level, node)
+		} else if isTarget {
+			p.setProof(level, left)
+		}
+
+		node = HashNodes(left, node)
+		isTarget = leftIsTarget || isTarget
+		level++
+	}
+}
+
+func (p *StreamProver) setProof(level int, sibling [32]byte) {
+	for len(p.proof) <= level {
+		p.proof = append(p.proof, [32]byte{})
+	}
+	p.proof[level] = sibling
+}
+
+// Run streams every leaf out of leaves, in order starting at index 0, and
+// returns the flat proof blob (matching Tree.Prove's format) for
+// targetIndex together with the resulting root.
+func (p *StreamProver) Run(leaves LeafSource) ([]byte, [32]byte, error) {
+	index := 0
+	for {
+		leaf, ok, err := leaves.Next()
+		if err != nil {
+			return nil, [32]byte{}, fmt.Errorf("error reading leaf %d: %w", index, err)
+		}
+		if !ok {
+			break
+		}
+		p.add(0, leaf, index == p.targetIndex)
+		if index == p.targetIndex {
+			p.leafSeen = true
+		}
+		index++
+	}
+	if !p.leafSeen {
+		return nil, [32]byte{}, fmt.Errorf("targetIndex %d out of range for %d leaves", p.targetIndex, index)
+	}
+
+	// Fold any leftover unpaired nodes up to the root, the same way
+	// StreamBuilder.Root does; an unpaired node at the top is the root
+	// itself, and an unpaired node beneath the top combines with the
+	// target's running node using the duplicate-last-node convention
+	// when it lies on the target's path.
+	node := p.target
+	isTarget := true
+	for level := 0; level < len(p.pending); level++ {
+		if p.pending[level] == nil {
+			continue
+		}
+		if p.isTarget[level] && isTarget {
+			// The target's own node reached this level unpaired;
+			// it is padded by duplicating itself.
+			p.setProof(level, *p.pending[level])
+			node = HashNodes(*p.pending[level], node)
+			continue
+		}
+		if isTarget {
+			p.setProof(level, *p.pending[level])
+		}
+		node = HashNodes(*p.pending[level], node)
+	}
+
+	var out []byte
+	for _, h := range p.proof {
+		out = append(out, h[:]...)
+	}
+	return out, node, nil
+}
+
+// ProvePath is a convenience wrapper around StreamProver for the common
+// case of only needing a single proof.
+func ProvePath(leaves LeafSource, targetIndex int) ([]byte, [32]byte, error) {
+	return NewStreamProver(targetIndex).Run(leaves)
+}
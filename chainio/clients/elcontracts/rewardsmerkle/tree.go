@@ -0,0 +1,87 @@
+// Package rewardsmerkle implements the generic binary Merkle tree used by
+// the RewardsCoordinator's two-level rewards tree (an earner tree of
+// per-earner token-tree roots). It is factored out of services/rewards so
+// that anything building or verifying one of these trees - the claim
+// builder, a streaming calculator over millions of earners, or a test fixture
+// generator - shares exactly one implementation of the hashing and padding
+// rules.
+package rewardsmerkle
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrEmptyTree is returned when a tree is built from zero leaves.
+var ErrEmptyTree = errors.New("rewardsmerkle: cannot build a tree with no leaves")
+
+// HashNodes combines a left and right node the way EigenLayer's Merkle.sol
+// (processInclusionProofKeccak) does: by position, not by value. Unlike
+// OpenZeppelin's MerkleProof, the RewardsCoordinator does not sort each
+// pair before hashing, so callers must pass left and right in the actual
+// tree order - every caller in this package does, since pairs are always
+// formed as (leftmost-added node, node that completes its pair).
+func HashNodes(left, right [32]byte) [32]byte {
+	return crypto.Keccak256Hash(left[:], right[:])
+}
+
+// Tree is a flat, bottom-up binary Merkle tree built from pre-hashed
+// leaves. layers[0] holds the leaves; the last layer holds the single root.
+// An odd layer is completed by duplicating its last node, matching the
+// padding convention used by the off-chain rewards-calculation pipeline
+// that produces the trees the RewardsCoordinator accepts.
+type Tree struct {
+	layers [][][32]byte
+}
+
+// BuildTree builds a Tree over leaves, which must already be the leaf
+// hashes (e.g. from CalculateEarnerLeafHash/CalculateTokenLeafHash), not
+// raw leaf data.
+func BuildTree(leaves [][32]byte) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, ErrEmptyTree
+	}
+	layers := [][][32]byte{leaves}
+	for len(layers[len(layers)-1]) > 1 {
+		cur := layers[len(layers)-1]
+		if len(cur)%2 == 1 {
+			cur = append(cur, cur[len(cur)-1])
+		}
+		next := make([][32]byte, len(cur)/2)
+		for i := range next {
+			next[i] = HashNodes(cur[2*i], cur[2*i+1])
+		}
+		layers = append(layers, next)
+	}
+	return &Tree{layers: layers}, nil
+}
+
+// Root returns the tree's root hash.
+func (t *Tree) Root() [32]byte {
+	return t.layers[len(t.layers)-1][0]
+}
+
+// Depth returns the number of layers above the leaves, i.e. the number of
+// sibling hashes a proof for this tree contains.
+func (t *Tree) Depth() int {
+	return len(t.layers) - 1
+}
+
+// Prove returns the sibling hashes from the leaf at index up to (but
+// excluding) the root, concatenated as the flat byte blob the contract's
+// ProcessClaim expects for EarnerTreeProof / TokenTreeProofs.
+func (t *Tree) Prove(index int) []byte {
+	var out []byte
+	for _, layer := range t.layers[:len(t.layers)-1] {
+		siblingIdx := index ^ 1
+		if siblingIdx >= len(layer) {
+			// Odd layer: the duplicated last node is its own sibling.
+			siblingIdx = index
+		}
+		sibling := layer[siblingIdx]
+		out = append(out, sibling[:]...)
+		index /= 2
+	}
+	return out
+}
@@ -0,0 +1,75 @@
+package rewardsmerkle
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// mustHex32 decodes a 64-char hex string into a [32]byte, panicking on a
+// malformed fixture constant.
+func mustHex32(s string) [32]byte {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 32 {
+		panic("bad fixture constant: " + s)
+	}
+	var out [32]byte
+	copy(out[:], b)
+	return out
+}
+
+// TestHashNodesMatchesMerkleSolConvention pins HashNodes/BuildTree/Prove/
+// verifyProof against a fixture independently computed by hand-walking
+// Merkle.sol's processInclusionProofKeccak convention: node pairs combine
+// by position (index parity), not by sorting the pair first the way
+// OpenZeppelin's MerkleProof does. If this ever regresses back to sorted
+// combination, a DistributionRoot computed by this package will stop
+// matching the root the real rewards-calculation pipeline (and therefore
+// checkClaim) produces for the same leaves.
+func TestHashNodesMatchesMerkleSolConvention(t *testing.T) {
+	leaves := [][32]byte{
+		mustHex32("bc36789e7a1e281436464229828f817d6612f7b477d66591ff96a9e064bcc98a"),
+		mustHex32("5fe7f977e71dba2ea1a68e21057beebb9be2ac30c6410aa38d4f3fbe41dcffd2"),
+		mustHex32("f2ee15ea639b73fa3db9b34a245bdfa015c260c598b211bf05a1ecc4b3e3b4f2"),
+		mustHex32("69c322e3248a5dfc29d73c5b0553b0185a35cd5bb6386747517ef7e53b15e287"),
+	}
+	wantN01 := mustHex32("57d772147cdf27f5f67d679f0f3a513f8b87622ce598a3cf0b048ab178ddfc6e")
+	wantN23 := mustHex32("2b07d07815e57c23883128aa268a683b3b39aca921fa5f247e9a30c4035d7107")
+	wantRoot := mustHex32("dd5115b5dcca3db0bffa31064a0d21f21362cd02e1263e47d69e38bbeec1d359")
+
+	if got := HashNodes(leaves[0], leaves[1]); got != wantN01 {
+		t.Fatalf("HashNodes(leaf0, leaf1) = %x, want %x", got, wantN01)
+	}
+	if got := HashNodes(leaves[2], leaves[3]); got != wantN23 {
+		t.Fatalf("HashNodes(leaf2, leaf3) = %x, want %x", got, wantN23)
+	}
+	if got := HashNodes(wantN01, wantN23); got != wantRoot {
+		t.Fatalf("HashNodes(n01, n23) = %x, want %x", got, wantRoot)
+	}
+
+	tree, err := BuildTree(leaves)
+	if err != nil {
+		t.Fatalf("BuildTree: %v", err)
+	}
+	if got := tree.Root(); got != wantRoot {
+		t.Fatalf("tree.Root() = %x, want %x", got, wantRoot)
+	}
+
+	for i, leaf := range leaves {
+		proof := tree.Prove(i)
+		if !verifyProof(proof, i, leaf, wantRoot) {
+			t.Fatalf("verifyProof failed for leaf %d", i)
+		}
+	}
+
+	// A proof's sibling hashes must combine by index parity, not be
+	// interchangeable - flipping leaf 1's proof order must not verify,
+	// since Merkle.sol's convention is position-sensitive.
+	proof1 := tree.Prove(1)
+	if len(proof1) != 64 {
+		t.Fatalf("expected a 2-level proof for leaf 1, got %d bytes", len(proof1))
+	}
+	swapped := append(append([]byte{}, proof1[32:]...), proof1[:32]...)
+	if verifyProof(swapped, 1, leaves[1], wantRoot) {
+		t.Fatal("verifyProof accepted a proof with sibling hashes out of order")
+	}
+}
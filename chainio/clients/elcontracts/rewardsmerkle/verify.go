@@ -0,0 +1,55 @@
+package rewardsmerkle
+
+import (
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// verifyProof walks proof (a flat concatenation of 32-byte sibling hashes,
+// as stored in EarnerTreeProof/TokenTreeProofs) from leaf at index up to a
+// root and reports whether it matches root. It reimplements the same
+// index-parity keccak combination Merkle.sol's processInclusionProofKeccak
+// uses on-chain - an even index combines as HashNodes(node, sibling), an
+// odd one as HashNodes(sibling, node) - so a claim can be validated
+// entirely off-chain.
+func verifyProof(proof []byte, index int, leaf [32]byte, root [32]byte) bool {
+	if len(proof)%32 != 0 {
+		return false
+	}
+	node := leaf
+	for i := 0; i*32 < len(proof); i++ {
+		var sibling [32]byte
+		copy(sibling[:], proof[i*32:i*32+32])
+		if index%2 == 0 {
+			node = HashNodes(node, sibling)
+		} else {
+			node = HashNodes(sibling, node)
+		}
+		index /= 2
+	}
+	return node == root
+}
+
+// VerifyClaim reimplements the RewardsCoordinator's CheckClaim natively in
+// Go: it checks that claim.EarnerLeaf proves into root via
+// EarnerTreeProof, that each claim.TokenLeaves[i] proves into
+// claim.EarnerLeaf.EarnerTokenRoot via claim.TokenTreeProofs[i], and that
+// the TokenLeaves/TokenIndices/TokenTreeProofs slices all have matching
+// lengths. It performs no RPC calls.
+func VerifyClaim(claim contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, root [32]byte) bool {
+	if len(claim.TokenLeaves) != len(claim.TokenIndices) || len(claim.TokenLeaves) != len(claim.TokenTreeProofs) {
+		return false
+	}
+
+	earnerLeafHash := EarnerLeafHash(claim.EarnerLeaf)
+	if !verifyProof(claim.EarnerTreeProof, int(claim.EarnerIndex), earnerLeafHash, root) {
+		return false
+	}
+
+	for i, leaf := range claim.TokenLeaves {
+		tokenLeafHash := TokenLeafHash(leaf)
+		if !verifyProof(claim.TokenTreeProofs[i], int(claim.TokenIndices[i]), tokenLeafHash, claim.EarnerLeaf.EarnerTokenRoot) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+// Package rewardssubmission validates and sorts the RewardsSubmission /
+// OperatorDirectedRewardsSubmission structs accepted by the
+// RewardsCoordinator, independent of any chain connection, so the same
+// checks can run in a CLI, a test, or a writer that's about to send a
+// transaction.
+package rewardssubmission
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// TimingParams are the on-chain constants StartTimestamp/Duration must be
+// validated against; callers read these from
+// CALCULATION_INTERVAL_SECONDS/MAX_REWARDS_DURATION.
+type TimingParams struct {
+	CalculationIntervalSeconds uint32
+	MaxRewardsDuration         uint32
+	MaxRetroactiveLength       uint32
+	MaxFutureLength            uint32
+	GenesisRewardsTimestamp    uint32
+}
+
+// ValidateTiming checks that startTimestamp/duration satisfy the
+// RewardsCoordinator's alignment and range invariants, given now (the
+// current block timestamp) and params.
+func ValidateTiming(startTimestamp, duration uint32, now uint32, params TimingParams) error {
+	if startTimestamp%params.CalculationIntervalSeconds != 0 {
+		return fmt.Errorf("StartTimestamp %d is not a multiple of CALCULATION_INTERVAL_SECONDS %d", startTimestamp, params.CalculationIntervalSeconds)
+	}
+	if duration%params.CalculationIntervalSeconds != 0 {
+		return fmt.Errorf("Duration %d is not a multiple of CALCULATION_INTERVAL_SECONDS %d", duration, params.CalculationIntervalSeconds)
+	}
+	if duration > params.MaxRewardsDuration {
+		return fmt.Errorf("Duration %d exceeds MAX_REWARDS_DURATION %d", duration, params.MaxRewardsDuration)
+	}
+	if startTimestamp < params.GenesisRewardsTimestamp {
+		return fmt.Errorf("StartTimestamp %d is before GENESIS_REWARDS_TIMESTAMP %d", startTimestamp, params.GenesisRewardsTimestamp)
+	}
+	if now > startTimestamp && now-startTimestamp > params.MaxRetroactiveLength {
+		return fmt.Errorf("StartTimestamp %d is more than MAX_RETROACTIVE_LENGTH %d seconds in the past", startTimestamp, params.MaxRetroactiveLength)
+	}
+	if startTimestamp > now && startTimestamp-now > params.MaxFutureLength {
+		return fmt.Errorf("StartTimestamp %d is more than MAX_FUTURE_LENGTH %d seconds in the future", startTimestamp, params.MaxFutureLength)
+	}
+	return nil
+}
+
+// SortStrategies reorders strategies by strategy address ascending, which
+// the RewardsCoordinator requires to be strictly increasing with no
+// duplicates, and errors if a duplicate is found.
+func SortStrategies(strategies []contractIRewardsCoordinator.IRewardsCoordinatorStrategyAndMultiplier) error {
+	sort.Slice(strategies, func(i, j int) bool {
+		return bytes.Compare(strategies[i].Strategy.Bytes(), strategies[j].Strategy.Bytes()) < 0
+	})
+	for i := 1; i < len(strategies); i++ {
+		if bytes.Equal(strategies[i].Strategy.Bytes(), strategies[i-1].Strategy.Bytes()) {
+			return fmt.Errorf("duplicate strategy %s in StrategiesAndMultipliers", strategies[i].Strategy)
+		}
+	}
+	return nil
+}
+
+// TotalRewardsSubmissionAmount returns the Amount field of a
+// RewardsSubmission, i.e. the allowance the RewardsCoordinator needs to pull
+// for it.
+func TotalRewardsSubmissionAmount(s contractIRewardsCoordinator.IRewardsCoordinatorRewardsSubmission) *big.Int {
+	return new(big.Int).Set(s.Amount)
+}
+
+// TotalOperatorDirectedAmount sums every OperatorReward.Amount in an
+// OperatorDirectedRewardsSubmission, i.e. the allowance the
+// RewardsCoordinator needs to pull for it.
+func TotalOperatorDirectedAmount(s contractIRewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission) *big.Int {
+	total := new(big.Int)
+	for _, r := range s.OperatorRewards {
+		total.Add(total, r.Amount)
+	}
+	return total
+}
+
+// ValidateOperatorDirectedRewardsSubmission runs ValidateTiming and
+// SortStrategies (in place) on s, and additionally rejects an empty
+// OperatorRewards list, which the contract would otherwise accept as a
+// no-op submission that wastes gas.
+func ValidateOperatorDirectedRewardsSubmission(
+	s *contractIRewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission,
+	now uint32,
+	params TimingParams,
+) error {
+	if len(s.OperatorRewards) == 0 {
+		return fmt.Errorf("OperatorDirectedRewardsSubmission has no OperatorRewards")
+	}
+	if err := ValidateTiming(s.StartTimestamp, s.Duration, now, params); err != nil {
+		return err
+	}
+	return SortStrategies(s.StrategiesAndMultipliers)
+}
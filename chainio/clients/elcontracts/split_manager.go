@@ -0,0 +1,142 @@
+package elcontracts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// PlannedSplitChange is one setter call OperatorSplitManager determined is
+// actually needed: the on-chain value for AVS (or PI, if AVS is the zero
+// address) differs from what policy wants.
+type PlannedSplitChange struct {
+	AVS     common.Address
+	IsPI    bool
+	Current uint16
+	Desired uint16
+}
+
+// SplitPlan is the diffed set of setter calls an OperatorSplitManager
+// would make to bring an operator's splits in line with a SplitPolicy,
+// along with the activation timeline those calls would follow if
+// submitted now.
+type SplitPlan struct {
+	Operator        common.Address
+	Changes         []PlannedSplitChange
+	ActivationDelay uint32
+	SubmitAt        uint32
+	ActivatesAt     uint32
+}
+
+// IsEmpty reports whether every AVS/PI split already matches the policy,
+// i.e. applying this plan would be a no-op.
+func (p *SplitPlan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// OperatorSplitManager computes and applies operator split changes for an
+// operator across many AVSs at once, from a pluggable SplitPolicy rather
+// than requiring a caller to compute and diff bips by hand for each AVS.
+type OperatorSplitManager struct {
+	coordinator splitCoordinator
+	logger      logging.Logger
+}
+
+// NewOperatorSplitManager returns an OperatorSplitManager backed by
+// coordinator.
+func NewOperatorSplitManager(coordinator splitCoordinator, logger logging.Logger) *OperatorSplitManager {
+	return &OperatorSplitManager{coordinator: coordinator, logger: logger}
+}
+
+// Plan computes policy's desired PI split and desired AVS split for every
+// AVS in avsList, fetches the corresponding current on-chain values, and
+// returns only the changes that differ, alongside the activation timeline
+// a submission made at now would follow. It errors if now is already too
+// late to reach wantEffectiveAt given the current ActivationDelay.
+func (m *OperatorSplitManager) Plan(
+	ctx context.Context,
+	operator common.Address,
+	avsList []common.Address,
+	policy SplitPolicy,
+	wantEffectiveAt uint32,
+	now uint32,
+) (*SplitPlan, error) {
+	delay, err := m.coordinator.ActivationDelay(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("error reading activation delay: %w", err)
+	}
+	if now+delay > wantEffectiveAt {
+		return nil, fmt.Errorf(
+			"splits set now would only activate at %d, which is after the requested %d (activation delay is %d seconds)",
+			now+delay, wantEffectiveAt, delay,
+		)
+	}
+
+	plan := &SplitPlan{
+		Operator:        operator,
+		ActivationDelay: delay,
+		SubmitAt:        now,
+		ActivatesAt:     now + delay,
+	}
+
+	desiredPI, err := policy.DesiredPISplit(ctx, operator)
+	if err != nil {
+		return nil, fmt.Errorf("error computing desired PI split: %w", err)
+	}
+	currentPI, err := m.coordinator.GetOperatorPISplit(&bind.CallOpts{Context: ctx}, operator)
+	if err != nil {
+		return nil, fmt.Errorf("error reading current PI split: %w", err)
+	}
+	if currentPI != desiredPI {
+		plan.Changes = append(plan.Changes, PlannedSplitChange{IsPI: true, Current: currentPI, Desired: desiredPI})
+	}
+
+	for _, avs := range avsList {
+		desired, err := policy.DesiredAVSSplit(ctx, operator, avs)
+		if err != nil {
+			return nil, fmt.Errorf("error computing desired split for avs %s: %w", avs, err)
+		}
+		current, err := m.coordinator.GetOperatorAVSSplit(&bind.CallOpts{Context: ctx}, operator, avs)
+		if err != nil {
+			return nil, fmt.Errorf("error reading current split for avs %s: %w", avs, err)
+		}
+		if current != desired {
+			plan.Changes = append(plan.Changes, PlannedSplitChange{AVS: avs, Current: current, Desired: desired})
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply submits one setter transaction per change in plan - SetOperatorPISplit
+// for the PI change, if any, and SetOperatorAVSSplit for each differing
+// AVS - skipping anything the plan already found unchanged. The
+// RewardsCoordinator has no multicall entry point of its own, so "batch"
+// here means exactly these transactions and nothing else, not a single
+// aggregated call.
+func (m *OperatorSplitManager) Apply(ctx context.Context, opts *bind.TransactOpts, plan *SplitPlan) ([]*types.Transaction, error) {
+	var txs []*types.Transaction
+	for _, change := range plan.Changes {
+		if change.IsPI {
+			tx, err := m.coordinator.SetOperatorPISplit(opts, plan.Operator, change.Desired)
+			if err != nil {
+				return txs, fmt.Errorf("error setting PI split: %w", err)
+			}
+			m.logger.Info("Applied operator PI split change", "operator", plan.Operator, "from", change.Current, "to", change.Desired)
+			txs = append(txs, tx)
+			continue
+		}
+		tx, err := m.coordinator.SetOperatorAVSSplit(opts, plan.Operator, change.AVS, change.Desired)
+		if err != nil {
+			return txs, fmt.Errorf("error setting split for avs %s: %w", change.AVS, err)
+		}
+		m.logger.Info("Applied operator AVS split change", "operator", plan.Operator, "avs", change.AVS, "from", change.Current, "to", change.Desired)
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
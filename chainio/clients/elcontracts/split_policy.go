@@ -0,0 +1,155 @@
+package elcontracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SplitPolicy decides the desired operator split, in bips, for a given AVS
+// and for the operator's programmatic-incentives (PI) split. It's the
+// pluggable decision-making OperatorSplitManager diffs against what's
+// currently on-chain.
+type SplitPolicy interface {
+	DesiredAVSSplit(ctx context.Context, operator, avs common.Address) (uint16, error)
+	DesiredPISplit(ctx context.Context, operator common.Address) (uint16, error)
+}
+
+// FixedSplit is a SplitPolicy that always returns a fixed, pre-configured
+// bips value per AVS and for PI.
+type FixedSplit struct {
+	AVS map[common.Address]uint16
+	PI  uint16
+}
+
+// DesiredAVSSplit implements SplitPolicy.
+func (f FixedSplit) DesiredAVSSplit(ctx context.Context, operator, avs common.Address) (uint16, error) {
+	bips, ok := f.AVS[avs]
+	if !ok {
+		return 0, fmt.Errorf("no fixed split configured for avs %s", avs)
+	}
+	return bips, nil
+}
+
+// DesiredPISplit implements SplitPolicy.
+func (f FixedSplit) DesiredPISplit(ctx context.Context, operator common.Address) (uint16, error) {
+	return f.PI, nil
+}
+
+// defaultSplitCaller is the subset of RewardsCoordinator read methods
+// needed to track the protocol-wide default operator split.
+type defaultSplitCaller interface {
+	DefaultOperatorSplitBips(opts *bind.CallOpts) (uint16, error)
+}
+
+// MatchAVSDefault is a SplitPolicy that always targets the
+// RewardsCoordinator's current DefaultOperatorSplitBips, for both AVS and
+// PI splits, so an operator that wants to track the protocol default
+// doesn't need to manually resubmit every time it changes.
+type MatchAVSDefault struct {
+	Coordinator defaultSplitCaller
+}
+
+// DesiredAVSSplit implements SplitPolicy.
+func (m MatchAVSDefault) DesiredAVSSplit(ctx context.Context, operator, avs common.Address) (uint16, error) {
+	bips, err := m.Coordinator.DefaultOperatorSplitBips(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("error reading default operator split: %w", err)
+	}
+	return bips, nil
+}
+
+// DesiredPISplit implements SplitPolicy.
+func (m MatchAVSDefault) DesiredPISplit(ctx context.Context, operator common.Address) (uint16, error) {
+	return m.DesiredAVSSplit(ctx, operator, common.Address{})
+}
+
+// TargetOperatorAPR is a SplitPolicy that defers the actual APR
+// calculation to caller-supplied functions - this module has no price
+// feed or APR model of its own - and only handles turning the result into
+// the SplitPolicy shape OperatorSplitManager expects.
+type TargetOperatorAPR struct {
+	// AVSBips computes the bips needed to hit the operator's target APR
+	// for a given AVS.
+	AVSBips func(ctx context.Context, operator, avs common.Address) (uint16, error)
+	// PIBips computes the bips needed to hit the operator's target APR
+	// from programmatic incentives.
+	PIBips func(ctx context.Context, operator common.Address) (uint16, error)
+}
+
+// DesiredAVSSplit implements SplitPolicy.
+func (t TargetOperatorAPR) DesiredAVSSplit(ctx context.Context, operator, avs common.Address) (uint16, error) {
+	return t.AVSBips(ctx, operator, avs)
+}
+
+// DesiredPISplit implements SplitPolicy.
+func (t TargetOperatorAPR) DesiredPISplit(ctx context.Context, operator common.Address) (uint16, error) {
+	return t.PIBips(ctx, operator)
+}
+
+// StakeTier is one rung of a TieredByStake policy: an operator whose
+// stake is at least MinStake, and below the next tier's MinStake, gets
+// Bips.
+type StakeTier struct {
+	MinStake *big.Int
+	Bips     uint16
+}
+
+// TieredByStake is a SplitPolicy that picks a split based on which stake
+// tier an operator currently falls into, for a given AVS (or overall, for
+// PI). Tiers need not be pre-sorted; DesiredAVSSplit/DesiredPISplit sort a
+// copy by MinStake ascending and pick the highest tier the stake clears.
+// Like TargetOperatorAPR, the actual stake lookup is delegated to a
+// caller-supplied function since this module has no stake oracle of its
+// own.
+type TieredByStake struct {
+	Tiers []StakeTier
+	// StakeOfAVS returns operator's current stake delegated to avs.
+	StakeOfAVS func(ctx context.Context, operator, avs common.Address) (*big.Int, error)
+	// StakeOfPI returns operator's current stake counted toward its PI
+	// split.
+	StakeOfPI func(ctx context.Context, operator common.Address) (*big.Int, error)
+}
+
+// DesiredAVSSplit implements SplitPolicy.
+func (t TieredByStake) DesiredAVSSplit(ctx context.Context, operator, avs common.Address) (uint16, error) {
+	stake, err := t.StakeOfAVS(ctx, operator, avs)
+	if err != nil {
+		return 0, fmt.Errorf("error reading stake for operator %s avs %s: %w", operator, avs, err)
+	}
+	return t.bipsForStake(stake)
+}
+
+// DesiredPISplit implements SplitPolicy.
+func (t TieredByStake) DesiredPISplit(ctx context.Context, operator common.Address) (uint16, error) {
+	stake, err := t.StakeOfPI(ctx, operator)
+	if err != nil {
+		return 0, fmt.Errorf("error reading PI stake for operator %s: %w", operator, err)
+	}
+	return t.bipsForStake(stake)
+}
+
+func (t TieredByStake) bipsForStake(stake *big.Int) (uint16, error) {
+	if len(t.Tiers) == 0 {
+		return 0, fmt.Errorf("no stake tiers configured")
+	}
+	sorted := append([]StakeTier(nil), t.Tiers...)
+	for i := 1; i < len(sorted); i++ {
+		for j := i; j > 0 && sorted[j].MinStake.Cmp(sorted[j-1].MinStake) < 0; j-- {
+			sorted[j], sorted[j-1] = sorted[j-1], sorted[j]
+		}
+	}
+	best := -1
+	for i, tier := range sorted {
+		if stake.Cmp(tier.MinStake) >= 0 {
+			best = i
+		}
+	}
+	if best == -1 {
+		return 0, fmt.Errorf("stake %s is below the lowest configured tier %s", stake, sorted[0].MinStake)
+	}
+	return sorted[best].Bips, nil
+}
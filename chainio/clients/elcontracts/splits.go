@@ -0,0 +1,119 @@
+package elcontracts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// splitCoordinator is the subset of RewardsCoordinator methods needed to
+// read and schedule operator splits.
+type splitCoordinator interface {
+	ActivationDelay(opts *bind.CallOpts) (uint32, error)
+	GetOperatorAVSSplit(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error)
+	GetOperatorPISplit(opts *bind.CallOpts, operator common.Address) (uint16, error)
+	SetOperatorAVSSplit(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) (*types.Transaction, error)
+	SetOperatorPISplit(opts *bind.TransactOpts, operator common.Address, split uint16) (*types.Transaction, error)
+}
+
+// SplitScheduler submits operator split changes ahead of when an operator
+// wants them to take effect, accounting for the RewardsCoordinator's
+// ActivationDelay: a split set now only takes effect ActivationDelay
+// seconds later, so naively calling SetOperatorAVSSplit/SetOperatorPISplit
+// right before a desired effective date is always too late.
+type SplitScheduler struct {
+	coordinator splitCoordinator
+	logger      logging.Logger
+}
+
+// NewSplitScheduler returns a SplitScheduler backed by coordinator.
+func NewSplitScheduler(coordinator splitCoordinator, logger logging.Logger) *SplitScheduler {
+	return &SplitScheduler{coordinator: coordinator, logger: logger}
+}
+
+// ScheduleAVSSplit sets operator's split for avs so that it activates at or
+// before wantEffectiveAt, returning an error if wantEffectiveAt is already
+// too soon given the current on-chain ActivationDelay.
+func (s *SplitScheduler) ScheduleAVSSplit(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	operator common.Address,
+	avs common.Address,
+	split uint16,
+	wantEffectiveAt uint32,
+	now uint32,
+) (*types.Transaction, error) {
+	if err := s.checkTiming(ctx, wantEffectiveAt, now); err != nil {
+		return nil, err
+	}
+	current, err := s.coordinator.GetOperatorAVSSplit(&bind.CallOpts{Context: ctx}, operator, avs)
+	if err != nil {
+		return nil, fmt.Errorf("error reading current AVS split: %w", err)
+	}
+	if current == split {
+		s.logger.Debug("AVS split already at target, skipping", "operator", operator, "avs", avs, "split", split)
+		return nil, nil
+	}
+	tx, err := s.coordinator.SetOperatorAVSSplit(opts, operator, avs, split)
+	if err != nil {
+		return nil, fmt.Errorf("error setting AVS split: %w", err)
+	}
+	s.logger.Info("Scheduled operator AVS split change", "operator", operator, "avs", avs, "split", split, "effectiveAt", wantEffectiveAt)
+	return tx, nil
+}
+
+// SchedulePISplit sets operator's programmatic-incentives split so that it
+// activates at or before wantEffectiveAt.
+func (s *SplitScheduler) SchedulePISplit(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	operator common.Address,
+	split uint16,
+	wantEffectiveAt uint32,
+	now uint32,
+) (*types.Transaction, error) {
+	if err := s.checkTiming(ctx, wantEffectiveAt, now); err != nil {
+		return nil, err
+	}
+	current, err := s.coordinator.GetOperatorPISplit(&bind.CallOpts{Context: ctx}, operator)
+	if err != nil {
+		return nil, fmt.Errorf("error reading current PI split: %w", err)
+	}
+	if current == split {
+		s.logger.Debug("PI split already at target, skipping", "operator", operator, "split", split)
+		return nil, nil
+	}
+	tx, err := s.coordinator.SetOperatorPISplit(opts, operator, split)
+	if err != nil {
+		return nil, fmt.Errorf("error setting PI split: %w", err)
+	}
+	s.logger.Info("Scheduled operator PI split change", "operator", operator, "split", split, "effectiveAt", wantEffectiveAt)
+	return tx, nil
+}
+
+func (s *SplitScheduler) checkTiming(ctx context.Context, wantEffectiveAt, now uint32) error {
+	return checkActivationTiming(ctx, s.coordinator, wantEffectiveAt, now)
+}
+
+// checkActivationTiming errors if a split set now, against coordinator's
+// current ActivationDelay, would activate later than wantEffectiveAt. It's
+// used by SplitScheduler; OperatorSplitManager.Plan performs the same
+// check inline, since it also records the delay it read in its plan.
+func checkActivationTiming(ctx context.Context, coordinator splitCoordinator, wantEffectiveAt, now uint32) error {
+	delay, err := coordinator.ActivationDelay(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("error reading activation delay: %w", err)
+	}
+	if now+delay > wantEffectiveAt {
+		return fmt.Errorf(
+			"split set now would only activate at %d, which is after the requested %d (activation delay is %d seconds)",
+			now+delay, wantEffectiveAt, delay,
+		)
+	}
+	return nil
+}
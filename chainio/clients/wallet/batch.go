@@ -0,0 +1,26 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// sendTransactionBatch submits each of txs in order via w, stopping at
+// the first error. It's the common SendTransactionBatch implementation
+// every Wallet backend shares, since submitting a batch is just
+// submitting each transaction in turn - none of them needs
+// backend-specific batching logic, unlike SendCalls' single-transaction
+// Multicall3 path.
+func sendTransactionBatch(ctx context.Context, w Wallet, txs []*types.Transaction) ([]TxID, error) {
+	txIDs := make([]TxID, 0, len(txs))
+	for i, tx := range txs {
+		txID, err := w.SendTransaction(ctx, tx)
+		if err != nil {
+			return txIDs, fmt.Errorf("error sending transaction %d of %d in batch: %w", i, len(txs), err)
+		}
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs, nil
+}
@@ -0,0 +1,34 @@
+package wallet
+
+import "github.com/ethereum/go-ethereum/common"
+
+// FireblocksWalletConfig gates fireblocksWallet behavior that goes
+// beyond a plain pass-through to the Fireblocks API. The zero value
+// keeps the strict, whitelist-only behavior fireblocksWallet has always
+// had.
+type FireblocksWalletConfig struct {
+	// AllowOneTimeAddresses lets SendTransaction fall back to a
+	// Fireblocks one-time-address contract call when the target isn't
+	// in the whitelisted contracts list, instead of failing outright.
+	// AVS workflows frequently need to call newly-deployed contracts
+	// (task managers, operator sets) whose addresses can't be
+	// whitelisted ahead of time.
+	AllowOneTimeAddresses bool
+	// OneTimeAddressPolicy, if set, is consulted before taking the
+	// one-time-address fallback and must return true for it to be used.
+	// A nil policy allows every address once AllowOneTimeAddresses is
+	// set.
+	OneTimeAddressPolicy func(common.Address) bool
+}
+
+// allowOneTimeAddress reports whether address may be used as a
+// Fireblocks one-time-address contract call target.
+func (t *fireblocksWallet) allowOneTimeAddress(address common.Address) bool {
+	if !t.config.AllowOneTimeAddresses {
+		return false
+	}
+	if t.config.OneTimeAddressPolicy == nil {
+		return true
+	}
+	return t.config.OneTimeAddressPolicy(address)
+}
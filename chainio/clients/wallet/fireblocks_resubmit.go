@@ -0,0 +1,195 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/fireblocks"
+)
+
+// pendingTx is the transaction fireblocksWallet most recently submitted
+// for a given nonce, kept around so EnableAutoResubmit's fee bumper knows
+// what to resubmit and since when it's been waiting.
+type pendingTx struct {
+	tx          *types.Transaction
+	submittedAt time.Time
+}
+
+// AutoResubmitConfig configures EnableAutoResubmit's background fee-bump
+// loop.
+type AutoResubmitConfig struct {
+	// PollInterval is how often pending transactions are checked.
+	// Defaults to 15s.
+	PollInterval time.Duration
+	// StuckAfter is how long a transaction may sit in the Fireblocks
+	// Submitted/Broadcasting status before it's resubmitted with a
+	// higher fee. Defaults to 5m.
+	StuckAfter time.Duration
+	// BumpFactor scales the previous maxFee/priorityFee (or gasPrice,
+	// for a legacy transaction) on each resubmission. Defaults to
+	// 1.125, matching go-ethereum's minimum replacement bump.
+	BumpFactor float64
+	// MaxFeeCap bounds how high maxFee (or gasPrice, for a legacy
+	// transaction) may be bumped to, in wei. A nil MaxFeeCap leaves the
+	// bump uncapped.
+	MaxFeeCap *big.Int
+}
+
+func (c AutoResubmitConfig) withDefaults() AutoResubmitConfig {
+	if c.PollInterval <= 0 {
+		c.PollInterval = 15 * time.Second
+	}
+	if c.StuckAfter <= 0 {
+		c.StuckAfter = 5 * time.Minute
+	}
+	if c.BumpFactor <= 1 {
+		c.BumpFactor = 1.125
+	}
+	return c
+}
+
+// EnableAutoResubmit starts a background loop that, every
+// cfg.PollInterval, resubmits any transaction that's been sitting in the
+// Fireblocks Submitted/Broadcasting status longer than cfg.StuckAfter -
+// with the same nonce, a replaceTxByHash pointing at its current hash,
+// and a fee bumped by cfg.BumpFactor. Calling it again replaces any
+// already-running loop.
+func (t *fireblocksWallet) EnableAutoResubmit(cfg AutoResubmitConfig) {
+	cfg = cfg.withDefaults()
+
+	t.mu.Lock()
+	if t.resubmitCancel != nil {
+		t.resubmitCancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.resubmitCancel = cancel
+	t.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.bumpStuckTransactions(ctx, cfg)
+			}
+		}
+	}()
+}
+
+func (t *fireblocksWallet) bumpStuckTransactions(ctx context.Context, cfg AutoResubmitConfig) {
+	now := time.Now()
+
+	t.mu.Lock()
+	stuck := make([]*pendingTx, 0)
+	for _, p := range t.pending {
+		if now.Sub(p.submittedAt) > cfg.StuckAfter {
+			stuck = append(stuck, p)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, p := range stuck {
+		if err := t.bumpTransaction(ctx, p, cfg); err != nil {
+			t.logger.Error("error bumping stuck Fireblocks transaction", "nonce", p.tx.Nonce(), "err", err)
+		}
+	}
+}
+
+func (t *fireblocksWallet) bumpTransaction(ctx context.Context, p *pendingTx, cfg AutoResubmitConfig) error {
+	nonce := p.tx.Nonce()
+
+	t.mu.Lock()
+	txID, ok := t.nonceToTxID[nonce]
+	t.mu.Unlock()
+	if !ok {
+		// Resolved (or replaced again) since the stuck scan; nothing to do.
+		return nil
+	}
+
+	fireblockTx, err := t.fireblocksClient.GetTransaction(ctx, txID)
+	if err != nil {
+		return fmt.Errorf("error getting fireblocks transaction %s: %w", txID, err)
+	}
+	if fireblockTx.Status != fireblocks.Submitted && fireblockTx.Status != fireblocks.Broadcasting {
+		// No longer stuck: either resolved, or in a status this loop
+		// shouldn't interfere with (e.g. pending approval).
+		return nil
+	}
+
+	bumped, err := bumpTransactionFee(p.tx, cfg)
+	if err != nil {
+		return err
+	}
+
+	newTxID, err := t.SendTransaction(ctx, bumped)
+	if err != nil {
+		return fmt.Errorf("error resubmitting bumped transaction: %w", err)
+	}
+
+	t.logger.Info("Bumped stuck Fireblocks transaction fee",
+		"nonce", nonce, "oldTxID", txID, "newTxID", newTxID, "bumpFactor", cfg.BumpFactor)
+	return nil
+}
+
+// bumpTransactionFee returns a copy of tx with its fee fields scaled by
+// cfg.BumpFactor and capped at cfg.MaxFeeCap, using a 1559 bump
+// (GasFeeCap/GasTipCap) when tx has those set and a legacy bump
+// (GasPrice) otherwise - the same distinction SendTransaction makes when
+// deciding which Fireblocks fee fields to populate.
+func bumpTransactionFee(tx *types.Transaction, cfg AutoResubmitConfig) (*types.Transaction, error) {
+	if tx.GasFeeCap().Sign() > 0 && tx.GasTipCap().Sign() > 0 {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  bumpWei(tx.GasTipCap(), cfg.BumpFactor, cfg.MaxFeeCap),
+			GasFeeCap:  bumpWei(tx.GasFeeCap(), cfg.BumpFactor, cfg.MaxFeeCap),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}), nil
+	}
+
+	if tx.GasPrice().Sign() > 0 {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: bumpWei(tx.GasPrice(), cfg.BumpFactor, cfg.MaxFeeCap),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	}
+
+	return nil, fmt.Errorf("transaction for nonce %d has no fee fields to bump", tx.Nonce())
+}
+
+func bumpWei(wei *big.Int, factor float64, cap *big.Int) *big.Int {
+	bumped, _ := new(big.Float).Mul(new(big.Float).SetInt(wei), big.NewFloat(factor)).Int(nil)
+	if cap != nil && bumped.Cmp(cap) > 0 {
+		return new(big.Int).Set(cap)
+	}
+	return bumped
+}
+
+// PendingTransactions implements Wallet.
+func (t *fireblocksWallet) PendingTransactions() []PendingTx {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pending := make([]PendingTx, 0, len(t.pending))
+	for nonce, p := range t.pending {
+		pending = append(pending, PendingTx{Nonce: nonce, TxID: t.nonceToTxID[nonce], Submitted: p.submittedAt})
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Nonce < pending[j].Nonce })
+	return pending
+}
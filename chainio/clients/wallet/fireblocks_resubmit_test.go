@@ -0,0 +1,89 @@
+package wallet
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestBumpWeiCapsAtMaxFeeCap(t *testing.T) {
+	wei := big.NewInt(1_000_000_000) // 1 gwei
+	cap := big.NewInt(1_050_000_000) // 1.05 gwei
+
+	got := bumpWei(wei, 1.125, cap)
+	if got.Cmp(cap) != 0 {
+		t.Fatalf("bumpWei = %s, want capped at %s", got, cap)
+	}
+}
+
+func TestBumpWeiUncappedWhenBelowMax(t *testing.T) {
+	wei := big.NewInt(1_000_000_000)
+	cap := big.NewInt(10_000_000_000)
+
+	got := bumpWei(wei, 1.125, cap)
+	want := big.NewInt(1_125_000_000)
+	if got.Cmp(want) != 0 {
+		t.Fatalf("bumpWei = %s, want %s", got, want)
+	}
+}
+
+func TestBumpWeiNilCapIsUncapped(t *testing.T) {
+	wei := big.NewInt(1_000_000_000)
+	got := bumpWei(wei, 2.0, nil)
+	want := big.NewInt(2_000_000_000)
+	if got.Cmp(want) != 0 {
+		t.Fatalf("bumpWei = %s, want %s", got, want)
+	}
+}
+
+// TestBumpTransactionFeeDynamicFeeCappedOnBothFields covers bumpTransactionFee's
+// 1559 path bumping GasTipCap and GasFeeCap independently against the same
+// MaxFeeCap, while preserving the transaction's other fields.
+func TestBumpTransactionFeeDynamicFeeCappedOnBothFields(t *testing.T) {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     3,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(2_000_000_000),
+		Gas:       21000,
+	})
+	cap := big.NewInt(1_500_000_000)
+
+	bumped, err := bumpTransactionFee(tx, AutoResubmitConfig{BumpFactor: 1.125, MaxFeeCap: cap})
+	if err != nil {
+		t.Fatalf("bumpTransactionFee: %v", err)
+	}
+	if bumped.GasTipCap().Cmp(big.NewInt(1_125_000_000)) != 0 {
+		t.Fatalf("GasTipCap = %s, want uncapped 1125000000", bumped.GasTipCap())
+	}
+	if bumped.GasFeeCap().Cmp(cap) != 0 {
+		t.Fatalf("GasFeeCap = %s, want capped at %s", bumped.GasFeeCap(), cap)
+	}
+	if bumped.Nonce() != tx.Nonce() {
+		t.Fatalf("Nonce = %d, want preserved %d", bumped.Nonce(), tx.Nonce())
+	}
+}
+
+func TestBumpTransactionFeeLegacyCapped(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    7,
+		GasPrice: big.NewInt(1_000_000_000),
+		Gas:      21000,
+	})
+	cap := big.NewInt(1_050_000_000)
+
+	bumped, err := bumpTransactionFee(tx, AutoResubmitConfig{BumpFactor: 1.125, MaxFeeCap: cap})
+	if err != nil {
+		t.Fatalf("bumpTransactionFee: %v", err)
+	}
+	if bumped.GasPrice().Cmp(cap) != 0 {
+		t.Fatalf("GasPrice = %s, want capped at %s", bumped.GasPrice(), cap)
+	}
+}
+
+func TestBumpTransactionFeeNoFeeFieldsErrors(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1, Gas: 21000})
+	if _, err := bumpTransactionFee(tx, AutoResubmitConfig{BumpFactor: 1.125}); err == nil {
+		t.Fatal("expected an error for a transaction with no fee fields to bump")
+	}
+}
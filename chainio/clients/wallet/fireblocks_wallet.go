@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 
@@ -57,6 +58,27 @@ type fireblocksWallet struct {
 	nonceToTxID map[uint64]TxID
 	txIDToNonce map[TxID]uint64
 
+	// pending tracks the most recently submitted transaction per nonce
+	// and when it was submitted, so EnableAutoResubmit's fee bumper can
+	// tell which ones have been sitting unconfirmed longer than
+	// AutoResubmitConfig.StuckAfter.
+	pending map[uint64]*pendingTx
+	// resubmitCancel stops a previously started EnableAutoResubmit loop,
+	// if any, so calling it again doesn't leak a second one.
+	resubmitCancel context.CancelFunc
+
+	// store, if set, persists nonceToTxID/txIDToNonce so they survive a
+	// process restart; nonceToTxID/txIDToNonce remain the read path and
+	// are treated as a cache over it. A nil store leaves this wallet
+	// exactly as in-memory as before NewFireblocksWalletWithStore
+	// existed.
+	store NonceStore
+
+	// config gates behavior beyond the Fireblocks API calls themselves,
+	// such as whether a contract call to a non-whitelisted address may
+	// fall back to a one-time-address transaction.
+	config FireblocksWalletConfig
+
 	// caches
 	account              *fireblocks.VaultAccount
 	whitelistedContracts map[common.Address]*fireblocks.WhitelistedContract
@@ -68,6 +90,37 @@ func NewFireblocksWallet(
 	ethClient ethClient,
 	vaultAccountName string,
 	logger logging.Logger,
+) (Wallet, error) {
+	return NewFireblocksWalletWithStore(fireblocksClient, ethClient, vaultAccountName, logger, nil)
+}
+
+// NewFireblocksWalletWithStore is NewFireblocksWallet, additionally
+// persisting nonce/TxID tracking through store so it survives a process
+// restart - e.g. an operator pod that's restarted by Kubernetes between
+// SendTransaction and GetTransactionReceipt. A nil store behaves exactly
+// like NewFireblocksWallet.
+func NewFireblocksWalletWithStore(
+	fireblocksClient fireblocks.Client,
+	ethClient ethClient,
+	vaultAccountName string,
+	logger logging.Logger,
+	store NonceStore,
+) (Wallet, error) {
+	return NewFireblocksWalletWithConfig(fireblocksClient, ethClient, vaultAccountName, logger, store, FireblocksWalletConfig{})
+}
+
+// NewFireblocksWalletWithConfig is NewFireblocksWalletWithStore,
+// additionally accepting cfg to control behavior such as whether
+// contract calls may fall back to a one-time address when the target
+// isn't whitelisted. The zero FireblocksWalletConfig keeps the
+// whitelist-only behavior of NewFireblocksWallet.
+func NewFireblocksWalletWithConfig(
+	fireblocksClient fireblocks.Client,
+	ethClient ethClient,
+	vaultAccountName string,
+	logger logging.Logger,
+	store NonceStore,
+	cfg FireblocksWalletConfig,
 ) (Wallet, error) {
 	chainID, err := ethClient.ChainID(context.Background())
 	if err != nil {
@@ -80,9 +133,12 @@ func NewFireblocksWallet(
 		vaultAccountName: vaultAccountName,
 		logger:           logger,
 		chainID:          chainID,
+		store:            store,
+		config:           cfg,
 
 		nonceToTxID: make(map[uint64]TxID),
 		txIDToNonce: make(map[TxID]uint64),
+		pending:     make(map[uint64]*pendingTx),
 
 		// caches
 		account:              nil,
@@ -169,6 +225,27 @@ func (t *fireblocksWallet) getWhitelistedContract(
 	return contract, nil
 }
 
+// lookupTxIDByNonceLocked returns the TxID most recently submitted for
+// nonce, checking the in-memory cache first and falling back to
+// t.store - populating the cache on a store hit - so a SendTransaction
+// replacing an in-flight nonce still finds it after a restart. Callers
+// must already hold t.mu.
+func (t *fireblocksWallet) lookupTxIDByNonceLocked(nonce uint64) (TxID, bool, error) {
+	if txID, ok := t.nonceToTxID[nonce]; ok {
+		return txID, true, nil
+	}
+	if t.store == nil {
+		return "", false, nil
+	}
+	txID, ok, err := t.store.GetByNonce(nonce)
+	if err != nil || !ok {
+		return "", false, err
+	}
+	t.nonceToTxID[nonce] = txID
+	t.txIDToNonce[txID] = nonce
+	return txID, true, nil
+}
+
 func (t *fireblocksWallet) SendTransaction(ctx context.Context, tx *types.Transaction) (TxID, error) {
 	assetID, ok := fireblocks.AssetIDByChain[t.chainID.Uint64()]
 	if !ok {
@@ -198,7 +275,9 @@ func (t *fireblocksWallet) SendTransaction(ctx context.Context, tx *types.Transa
 	// we need to get the replacement transaction hash and use it as the replaceTxByHash parameter
 	replaceTxByHash := ""
 	nonce := tx.Nonce()
-	if txID, ok := t.nonceToTxID[nonce]; ok {
+	if txID, ok, err := t.lookupTxIDByNonceLocked(nonce); err != nil {
+		return "", fmt.Errorf("error looking up nonce %d in nonce store: %w", nonce, err)
+	} else if ok {
 		fireblockTx, err := t.fireblocksClient.GetTransaction(ctx, txID)
 		if err != nil {
 			return "", fmt.Errorf("error getting fireblocks transaction %s: %w", txID, err)
@@ -250,24 +329,44 @@ func (t *fireblocksWallet) SendTransaction(ctx context.Context, tx *types.Transa
 		res, err = t.fireblocksClient.Transfer(ctx, req)
 	} else if len(tx.Data()) > 0 {
 		contract, clientErr := t.getWhitelistedContract(ctx, *tx.To())
-		if clientErr != nil {
+		switch {
+		case clientErr == nil:
+			req := fireblocks.NewContractCallRequest(
+				"", // externalTxID
+				assetID,
+				account.ID,                      // source account ID
+				contract.ID,                     // destination account ID
+				weiToEther(tx.Value()).String(), // amount
+				hexutil.Encode(tx.Data()),       // calldata
+				replaceTxByHash,                 // replaceTxByHash
+				gasPrice,
+				gasLimit,
+				maxFee,
+				priorityFee,
+				feeLevel,
+			)
+			res, err = t.fireblocksClient.ContractCall(ctx, req)
+		case t.allowOneTimeAddress(*tx.To()):
+			t.logger.Info("Sending Fireblocks contract call to a non-whitelisted one-time address",
+				"address", tx.To().Hex())
+			req := fireblocks.NewOneTimeContractCallRequest(
+				"", // externalTxID
+				assetID,
+				account.ID,                      // source account ID
+				tx.To().Hex(),                   // destination address
+				weiToEther(tx.Value()).String(), // amount
+				hexutil.Encode(tx.Data()),       // calldata
+				replaceTxByHash,                 // replaceTxByHash
+				gasPrice,
+				gasLimit,
+				maxFee,
+				priorityFee,
+				feeLevel,
+			)
+			res, err = t.fireblocksClient.ContractCall(ctx, req)
+		default:
 			return "", fmt.Errorf("error getting whitelisted contract %s: %w", tx.To().Hex(), clientErr)
 		}
-		req := fireblocks.NewContractCallRequest(
-			"", // externalTxID
-			assetID,
-			account.ID,                      // source account ID
-			contract.ID,                     // destination account ID
-			weiToEther(tx.Value()).String(), // amount
-			hexutil.Encode(tx.Data()),       // calldata
-			replaceTxByHash,                 // replaceTxByHash
-			gasPrice,
-			gasLimit,
-			maxFee,
-			priorityFee,
-			feeLevel,
-		)
-		res, err = t.fireblocksClient.ContractCall(ctx, req)
 	} else {
 		return "", errors.New("transaction has no value and no data")
 	}
@@ -277,11 +376,21 @@ func (t *fireblocksWallet) SendTransaction(ctx context.Context, tx *types.Transa
 	}
 	t.nonceToTxID[nonce] = res.ID
 	t.txIDToNonce[res.ID] = nonce
+	t.pending[nonce] = &pendingTx{tx: tx, submittedAt: time.Now()}
+	if t.store != nil {
+		if err := t.store.Put(nonce, res.ID); err != nil {
+			return "", fmt.Errorf("error persisting nonce %d in nonce store: %w", nonce, err)
+		}
+	}
 	t.logger.Debug("Fireblocks contract call complete", "txID", res.ID, "status", res.Status)
 
 	return res.ID, nil
 }
 
+func (t *fireblocksWallet) SendTransactionBatch(ctx context.Context, txs []*types.Transaction) ([]TxID, error) {
+	return sendTransactionBatch(ctx, t, txs)
+}
+
 func (t *fireblocksWallet) CancelTransactionBroadcast(ctx context.Context, txID TxID) (bool, error) {
 	return t.fireblocksClient.CancelTransaction(ctx, string(txID))
 }
@@ -300,6 +409,12 @@ func (t *fireblocksWallet) GetTransactionReceipt(ctx context.Context, txID TxID)
 			if nonce, ok := t.txIDToNonce[txID]; ok {
 				delete(t.nonceToTxID, nonce)
 				delete(t.txIDToNonce, txID)
+				delete(t.pending, nonce)
+			}
+			if t.store != nil {
+				if err := t.store.Delete(txID); err != nil {
+					t.logger.Error("error deleting resolved transaction from nonce store", "txID", txID, "err", err)
+				}
 			}
 
 			return receipt, nil
@@ -313,6 +428,18 @@ func (t *fireblocksWallet) GetTransactionReceipt(ctx context.Context, txID TxID)
 		fireblockTx.Status == fireblocks.Rejected ||
 		fireblockTx.Status == fireblocks.Cancelled ||
 		fireblockTx.Status == fireblocks.Blocked {
+		t.mu.Lock()
+		if nonce, ok := t.txIDToNonce[txID]; ok {
+			delete(t.nonceToTxID, nonce)
+			delete(t.txIDToNonce, txID)
+			delete(t.pending, nonce)
+		}
+		t.mu.Unlock()
+		if t.store != nil {
+			if err := t.store.Delete(txID); err != nil {
+				t.logger.Error("error deleting failed transaction from nonce store", "txID", txID, "err", err)
+			}
+		}
 		return nil, fmt.Errorf("%w: the Fireblocks transaction %s has been %s", ErrTransactionFailed, txID, fireblockTx.Status)
 	} else if fireblockTx.Status == fireblocks.Submitted ||
 		fireblockTx.Status == fireblocks.PendingScreening ||
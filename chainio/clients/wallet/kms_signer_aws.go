@@ -0,0 +1,89 @@
+package wallet
+
+import (
+	"context"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// AWSKMSConfig identifies the asymmetric ECC_SECG_P256K1 key AWS KMS
+// signs with.
+type AWSKMSConfig struct {
+	// KeyID is the KMS key ID or ARN.
+	KeyID string
+	// Region overrides the region in the ambient AWS config, if set.
+	Region string
+}
+
+// awsKMSSigner signs via the AWS KMS Sign API using an ECC_SECG_P256K1
+// asymmetric key.
+type awsKMSSigner struct {
+	client *kms.Client
+	keyID  string
+}
+
+func newAWSKMSSigner(ctx context.Context, cfg AWSKMSConfig) (*awsKMSSigner, error) {
+	var opts []func(*config.LoadOptions) error
+	if cfg.Region != "" {
+		opts = append(opts, config.WithRegion(cfg.Region))
+	}
+	awsCfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error loading AWS config: %w", err)
+	}
+	return &awsKMSSigner{client: kms.NewFromConfig(awsCfg), keyID: cfg.KeyID}, nil
+}
+
+func (s *awsKMSSigner) publicKey(ctx context.Context) ([]byte, error) {
+	out, err := s.client.GetPublicKey(ctx, &kms.GetPublicKeyInput{KeyId: aws.String(s.keyID)})
+	if err != nil {
+		return nil, fmt.Errorf("error getting AWS KMS public key: %w", err)
+	}
+	return parseASN1SubjectPublicKey(out.PublicKey)
+}
+
+func (s *awsKMSSigner) signDigest(ctx context.Context, digest []byte) (*big.Int, *big.Int, error) {
+	out, err := s.client.Sign(ctx, &kms.SignInput{
+		KeyId:            aws.String(s.keyID),
+		Message:          digest,
+		MessageType:      types.MessageTypeDigest,
+		SigningAlgorithm: types.SigningAlgorithmSpecEcdsaSha256,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error signing digest with AWS KMS: %w", err)
+	}
+	return parseASN1ECDSASignature(out.Signature)
+}
+
+// parseASN1SubjectPublicKey extracts the raw uncompressed EC point from a
+// DER SubjectPublicKeyInfo, which is the format AWS KMS's GetPublicKey
+// returns.
+func parseASN1SubjectPublicKey(der []byte) ([]byte, error) {
+	var spki struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(der, &spki); err != nil {
+		return nil, fmt.Errorf("error parsing SubjectPublicKeyInfo: %w", err)
+	}
+	return spki.PublicKey.Bytes, nil
+}
+
+// parseASN1ECDSASignature decodes the DER ECDSA-Sig-Value AWS KMS returns
+// from Sign into its (r, s) components.
+func parseASN1ECDSASignature(der []byte) (*big.Int, *big.Int, error) {
+	var sig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, nil, fmt.Errorf("error parsing ECDSA signature: %w", err)
+	}
+	return sig.R, sig.S, nil
+}
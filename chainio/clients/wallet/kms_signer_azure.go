@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azkeys"
+)
+
+// AzureKMSConfig identifies the EC-SECP256K1 key Azure Key Vault signs
+// with.
+type AzureKMSConfig struct {
+	// VaultURL is the Key Vault's base URL, e.g.
+	// "https://my-vault.vault.azure.net/".
+	VaultURL string
+	// KeyName is the key's name within the vault.
+	KeyName string
+	// KeyVersion pins a specific version; the latest version is used if
+	// empty.
+	KeyVersion string
+}
+
+// azureKMSSigner signs via Azure Key Vault's Sign operation using an
+// EC-SECP256K1 key.
+type azureKMSSigner struct {
+	client     *azkeys.Client
+	keyName    string
+	keyVersion string
+}
+
+func newAzureKMSSigner(ctx context.Context, cfg AzureKMSConfig) (*azureKMSSigner, error) {
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Azure credential: %w", err)
+	}
+	client, err := azkeys.NewClient(cfg.VaultURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Azure Key Vault client: %w", err)
+	}
+	return &azureKMSSigner{client: client, keyName: cfg.KeyName, keyVersion: cfg.KeyVersion}, nil
+}
+
+func (s *azureKMSSigner) publicKey(ctx context.Context) ([]byte, error) {
+	resp, err := s.client.GetKey(ctx, s.keyName, s.keyVersion, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Azure Key Vault public key: %w", err)
+	}
+	if resp.Key == nil || resp.Key.X == nil || resp.Key.Y == nil {
+		return nil, fmt.Errorf("key %s is missing EC coordinates", s.keyName)
+	}
+	// Key Vault returns the JWK's X/Y coordinates separately; the
+	// uncompressed point form crypto.UnmarshalPubkey expects is
+	// 0x04 || X || Y, each 32 bytes.
+	point := make([]byte, 0, 65)
+	point = append(point, 0x04)
+	point = append(point, leftPad32(resp.Key.X)...)
+	point = append(point, leftPad32(resp.Key.Y)...)
+	return point, nil
+}
+
+func (s *azureKMSSigner) signDigest(ctx context.Context, digest []byte) (*big.Int, *big.Int, error) {
+	algorithm := azkeys.SignatureAlgorithmES256K
+	params := azkeys.SignParameters{
+		Algorithm: &algorithm,
+		Value:     digest,
+	}
+	resp, err := s.client.Sign(ctx, s.keyName, s.keyVersion, params, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error signing digest with Azure Key Vault: %w", err)
+	}
+	// Key Vault returns a raw, fixed-width r || s signature (not DER) for
+	// EC keys.
+	sig := resp.Result
+	if len(sig) != 64 {
+		return nil, nil, fmt.Errorf("unexpected Azure Key Vault signature length %d", len(sig))
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s2 := new(big.Int).SetBytes(sig[32:])
+	return r, s2, nil
+}
+
+func leftPad32(b []byte) []byte {
+	if len(b) >= 32 {
+		return b[len(b)-32:]
+	}
+	padded := make([]byte, 32)
+	copy(padded[32-len(b):], b)
+	return padded
+}
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/kms/apiv1/kmspb"
+)
+
+// GCPKMSConfig identifies the asymmetric EC_SIGN_SECP256K1_SHA256 key
+// Cloud KMS signs with.
+type GCPKMSConfig struct {
+	// KeyVersionName is the fully qualified resource name, e.g.
+	// "projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1".
+	KeyVersionName string
+}
+
+// gcpKMSSigner signs via Cloud KMS's AsymmetricSign using an
+// EC_SIGN_SECP256K1_SHA256 key.
+type gcpKMSSigner struct {
+	client         *kms.KeyManagementClient
+	keyVersionName string
+}
+
+func newGCPKMSSigner(ctx context.Context, cfg GCPKMSConfig) (*gcpKMSSigner, error) {
+	client, err := kms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Cloud KMS client: %w", err)
+	}
+	return &gcpKMSSigner{client: client, keyVersionName: cfg.KeyVersionName}, nil
+}
+
+func (s *gcpKMSSigner) publicKey(ctx context.Context) ([]byte, error) {
+	resp, err := s.client.GetPublicKey(ctx, &kmspb.GetPublicKeyRequest{Name: s.keyVersionName})
+	if err != nil {
+		return nil, fmt.Errorf("error getting Cloud KMS public key: %w", err)
+	}
+	return parsePEMSubjectPublicKey([]byte(resp.Pem))
+}
+
+func (s *gcpKMSSigner) signDigest(ctx context.Context, digest []byte) (*big.Int, *big.Int, error) {
+	resp, err := s.client.AsymmetricSign(ctx, &kmspb.AsymmetricSignRequest{
+		Name:   s.keyVersionName,
+		Digest: &kmspb.Digest{Digest: &kmspb.Digest_Sha256{Sha256: digest}},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error signing digest with Cloud KMS: %w", err)
+	}
+	return parseASN1ECDSASignature(resp.Signature)
+}
+
+// parsePEMSubjectPublicKey decodes a PEM-wrapped DER SubjectPublicKeyInfo,
+// which is the format Cloud KMS's GetPublicKey returns.
+func parsePEMSubjectPublicKey(pemBytes []byte) ([]byte, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("error decoding PEM public key")
+	}
+	return parseASN1SubjectPublicKey(block.Bytes)
+}
@@ -0,0 +1,177 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// multicall3Address is Multicall3's canonical deployment address, the
+// same across every chain it's been deployed to.
+var multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// Call is one sub-call SendCalls packs into a single Multicall3
+// aggregate3Value transaction.
+type Call struct {
+	To    common.Address
+	Data  []byte
+	Value *big.Int
+	// AllowFailure lets this call revert without reverting the whole
+	// batch.
+	AllowFailure bool
+}
+
+// CallResult is one Call's outcome, reconstructed from a mined receipt
+// by DecodeMulticallReceipt.
+type CallResult struct {
+	// Success is nil when DecodeMulticallReceipt can't tell whether an
+	// AllowFailure call succeeded or silently reverted - see its doc
+	// comment. Callers that need a definite answer for such a call must
+	// re-simulate it (e.g. via eth_call at the receipt's block) rather
+	// than trust a guessed Success.
+	Success *bool
+	// ReturnData is the first log emitted by the Call's target address,
+	// if any - see DecodeMulticallReceipt's doc comment for why this is
+	// a best-effort substitute for the call's actual return bytes.
+	ReturnData []byte
+}
+
+// multicall3Call3Value mirrors Multicall3's Call3Value struct field for
+// field, so the abi package can pack it as the tuple aggregate3Value
+// expects.
+type multicall3Call3Value struct {
+	Target       common.Address
+	AllowFailure bool
+	Value        *big.Int
+	CallData     []byte
+}
+
+const multicall3ABIJSON = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3Value[]","name":"calls","type":"tuple[]"}],"name":"aggregate3Value","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+func packAggregate3Value(calls []Call) ([]byte, *big.Int, error) {
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABIJSON))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing multicall3 ABI: %w", err)
+	}
+
+	packedCalls := make([]multicall3Call3Value, len(calls))
+	totalValue := new(big.Int)
+	for i, c := range calls {
+		value := c.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		packedCalls[i] = multicall3Call3Value{
+			Target:       c.To,
+			AllowFailure: c.AllowFailure,
+			Value:        value,
+			CallData:     c.Data,
+		}
+		totalValue.Add(totalValue, value)
+	}
+
+	data, err := parsed.Pack("aggregate3Value", packedCalls)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error packing aggregate3Value call: %w", err)
+	}
+	return data, totalValue, nil
+}
+
+// SendCalls packs calls into a single Multicall3 aggregate3Value
+// transaction and submits it via w. template supplies the nonce and fee
+// fields the caller would otherwise pass to SendTransaction directly -
+// SendCalls only overrides its To, Value and Data.
+func SendCalls(ctx context.Context, w Wallet, template *types.Transaction, calls []Call) (TxID, error) {
+	data, value, err := packAggregate3Value(calls)
+	if err != nil {
+		return "", err
+	}
+	return w.SendTransaction(ctx, retarget(template, multicall3Address, value, data))
+}
+
+// retarget returns a copy of tx pointed at to with the given value and
+// data, preserving tx's nonce, gas and fee fields and its 1559-vs-legacy
+// shape - the same distinction bumpTransactionFee makes when rebuilding
+// a transaction for resubmission.
+func retarget(tx *types.Transaction, to common.Address, value *big.Int, data []byte) *types.Transaction {
+	if tx.GasFeeCap().Sign() > 0 && tx.GasTipCap().Sign() > 0 {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: tx.GasTipCap(),
+			GasFeeCap: tx.GasFeeCap(),
+			Gas:       tx.Gas(),
+			To:        &to,
+			Value:     value,
+			Data:      data,
+		})
+	}
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    tx.Nonce(),
+		GasPrice: tx.GasPrice(),
+		Gas:      tx.Gas(),
+		To:       &to,
+		Value:    value,
+		Data:     data,
+	})
+}
+
+// DecodeMulticallReceipt reconstructs each Call's outcome from receipt.
+// aggregate3Value's own Result[] return value isn't recoverable from a
+// mined receipt - a transaction receipt only carries a status, gas used
+// and emitted logs, never its return data - so this instead attributes
+// receipt's logs to calls by matching each log's address against the
+// call's target, in call order.
+//
+// If the overall transaction reverted, every call is reported failed
+// (a revert rolls back all of its logs too, so there's nothing further
+// to attribute). If it succeeded, every call without AllowFailure
+// necessarily succeeded - otherwise the whole batch would have reverted.
+// An AllowFailure call that emitted a log is reported successful, since a
+// reverted call can't have emitted one; but an AllowFailure call that
+// emitted no log gets a nil Success rather than a guessed one, because a
+// receipt can't tell a successful call that simply didn't log anything
+// (a plain value transfer, a setter with no event) apart from one that
+// silently reverted - use CallResult.ReturnData's absence only to decide
+// whether to re-simulate, never a defaulted Success.
+func DecodeMulticallReceipt(receipt *types.Receipt, calls []Call) ([]CallResult, error) {
+	if receipt == nil {
+		return nil, errors.New("receipt is nil")
+	}
+
+	trueVal, falseVal := true, false
+
+	logsByAddress := make(map[common.Address][]*types.Log)
+	for _, log := range receipt.Logs {
+		logsByAddress[log.Address] = append(logsByAddress[log.Address], log)
+	}
+
+	overallSuccess := receipt.Status == types.ReceiptStatusSuccessful
+	results := make([]CallResult, len(calls))
+	for i, c := range calls {
+		logs := logsByAddress[c.To]
+		hasLog := len(logs) > 0
+		if hasLog {
+			results[i].ReturnData = logs[0].Data
+			logsByAddress[c.To] = logs[1:]
+		}
+
+		switch {
+		case !overallSuccess:
+			results[i].Success = &falseVal
+		case !c.AllowFailure:
+			results[i].Success = &trueVal
+		case hasLog:
+			results[i].Success = &trueVal
+		default:
+			results[i].Success = nil
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,72 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TestDecodeMulticallReceiptAllowFailureSuccess covers DecodeMulticallReceipt's
+// four outcome branches, in particular that a log-less AllowFailure call
+// under an overall-successful receipt gets a nil (unknown) Success rather
+// than a guessed false - see its doc comment for why a receipt can't tell
+// the two cases apart.
+func TestDecodeMulticallReceiptAllowFailureSuccess(t *testing.T) {
+	target := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	t.Run("allow-failure call with no log is unknown", func(t *testing.T) {
+		calls := []Call{{To: target, AllowFailure: true}}
+		receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful}
+
+		results, err := DecodeMulticallReceipt(receipt, calls)
+		if err != nil {
+			t.Fatalf("DecodeMulticallReceipt: %v", err)
+		}
+		if results[0].Success != nil {
+			t.Fatalf("Success = %v, want nil (unknown)", *results[0].Success)
+		}
+	})
+
+	t.Run("allow-failure call with a log is true", func(t *testing.T) {
+		calls := []Call{{To: target, AllowFailure: true}}
+		receipt := &types.Receipt{
+			Status: types.ReceiptStatusSuccessful,
+			Logs:   []*types.Log{{Address: target, Data: []byte("x")}},
+		}
+
+		results, err := DecodeMulticallReceipt(receipt, calls)
+		if err != nil {
+			t.Fatalf("DecodeMulticallReceipt: %v", err)
+		}
+		if results[0].Success == nil || !*results[0].Success {
+			t.Fatalf("Success = %v, want true", results[0].Success)
+		}
+	})
+
+	t.Run("overall revert is false regardless of AllowFailure", func(t *testing.T) {
+		calls := []Call{{To: target, AllowFailure: true}}
+		receipt := &types.Receipt{Status: types.ReceiptStatusFailed}
+
+		results, err := DecodeMulticallReceipt(receipt, calls)
+		if err != nil {
+			t.Fatalf("DecodeMulticallReceipt: %v", err)
+		}
+		if results[0].Success == nil || *results[0].Success {
+			t.Fatalf("Success = %v, want false", results[0].Success)
+		}
+	})
+
+	t.Run("non-allow-failure call under success is true", func(t *testing.T) {
+		calls := []Call{{To: target, AllowFailure: false}}
+		receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful}
+
+		results, err := DecodeMulticallReceipt(receipt, calls)
+		if err != nil {
+			t.Fatalf("DecodeMulticallReceipt: %v", err)
+		}
+		if results[0].Success == nil || !*results[0].Success {
+			t.Fatalf("Success = %v, want true", results[0].Success)
+		}
+	})
+}
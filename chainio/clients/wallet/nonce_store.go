@@ -0,0 +1,21 @@
+package wallet
+
+// NonceStore persists the nonce/TxID bookkeeping fireblocksWallet
+// otherwise only keeps in memory, so a restarted operator process can
+// still recognize an in-flight nonce as a replacement instead of
+// submitting a duplicate transaction, and can still resolve a TxID it
+// already submitted before the restart.
+type NonceStore interface {
+	// Put records that nonce's most recently submitted transaction is
+	// txID, overwriting whatever was previously stored for nonce.
+	Put(nonce uint64, txID TxID) error
+	// GetByNonce returns the TxID most recently stored for nonce, and
+	// false if none is stored.
+	GetByNonce(nonce uint64) (TxID, bool, error)
+	// GetByTxID returns the nonce txID was stored under, and false if
+	// it isn't known.
+	GetByTxID(txID TxID) (uint64, bool, error)
+	// Delete removes txID, and the nonce it was stored under, once it's
+	// been resolved (confirmed or failed) and no longer needs tracking.
+	Delete(txID TxID) error
+}
@@ -0,0 +1,121 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// badgerNonceStore is a NonceStore backed by an embedded BadgerDB,
+// following the same "n:<nonce>" / "t:<txID>" two-key-per-mapping layout
+// as boltNonceStore.
+type badgerNonceStore struct {
+	db *badger.DB
+}
+
+// NewBadgerNonceStore opens (creating if necessary) a BadgerDB database
+// at path for use as a NonceStore.
+func NewBadgerNonceStore(path string) (NonceStore, error) {
+	db, err := badger.Open(badger.DefaultOptions(path))
+	if err != nil {
+		return nil, fmt.Errorf("error opening badger database %s: %w", path, err)
+	}
+	return &badgerNonceStore{db: db}, nil
+}
+
+func (s *badgerNonceStore) Put(nonce uint64, txID TxID) error {
+	return s.db.Update(func(txn *badger.Txn) error {
+		// A nonce's previously-stored TxID, if any, is being replaced -
+		// its reverse-index entry would otherwise keep resolving to
+		// nonce even though it's no longer nonce's current TxID.
+		item, err := txn.Get(nonceKey(nonce))
+		if err != nil && !errors.Is(err, badger.ErrKeyNotFound) {
+			return err
+		}
+		if err == nil {
+			var old []byte
+			if err := item.Value(func(v []byte) error {
+				old = append([]byte{}, v...)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := txn.Delete(txIDKey(TxID(old))); err != nil {
+				return err
+			}
+		}
+		if err := txn.Set(nonceKey(nonce), []byte(txID)); err != nil {
+			return err
+		}
+		return txn.Set(txIDKey(txID), nonceBytes(nonce))
+	})
+}
+
+func (s *badgerNonceStore) GetByNonce(nonce uint64) (TxID, bool, error) {
+	var txID TxID
+	var found bool
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(nonceKey(nonce))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(v []byte) error {
+			txID, found = TxID(v), true
+			return nil
+		})
+	})
+	return txID, found, err
+}
+
+func (s *badgerNonceStore) GetByTxID(txID TxID) (uint64, bool, error) {
+	var nonce uint64
+	var found bool
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(txIDKey(txID))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(v []byte) error {
+			if len(v) != 8 {
+				return errors.New("corrupt nonce value in badger nonce store")
+			}
+			nonce, found = binary.BigEndian.Uint64(v), true
+			return nil
+		})
+	})
+	return nonce, found, err
+}
+
+func (s *badgerNonceStore) Delete(txID TxID) error {
+	return s.db.Update(func(txn *badger.Txn) error {
+		item, err := txn.Get(txIDKey(txID))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var nonce uint64
+		if err := item.Value(func(v []byte) error {
+			if len(v) != 8 {
+				return errors.New("corrupt nonce value in badger nonce store")
+			}
+			nonce = binary.BigEndian.Uint64(v)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := txn.Delete(nonceKey(nonce)); err != nil {
+			return err
+		}
+		return txn.Delete(txIDKey(txID))
+	})
+}
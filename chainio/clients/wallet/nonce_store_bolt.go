@@ -0,0 +1,115 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+)
+
+// boltNonceStoreBucket is the single bucket boltNonceStore keeps both of
+// its indexes in, keyed by "n:<nonce>" and "t:<txID>" respectively so a
+// single bbolt transaction can update both sides of the mapping
+// atomically.
+var boltNonceStoreBucket = []byte("wallet_nonce_store")
+
+// boltNonceStore is a NonceStore backed by a local BoltDB file, for
+// operators that want nonce/TxID tracking to survive a process restart
+// without standing up a separate store like Redis.
+type boltNonceStore struct {
+	db *bbolt.DB
+}
+
+// NewBoltNonceStore opens (creating if necessary) a BoltDB database at
+// path for use as a NonceStore.
+func NewBoltNonceStore(path string) (NonceStore, error) {
+	db, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error opening bolt database %s: %w", path, err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltNonceStoreBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating bolt bucket: %w", err)
+	}
+	return &boltNonceStore{db: db}, nil
+}
+
+func (s *boltNonceStore) Put(nonce uint64, txID TxID) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(boltNonceStoreBucket)
+		// A nonce's previously-stored TxID, if any, is being replaced -
+		// its reverse-index entry would otherwise keep resolving to
+		// nonce even though it's no longer nonce's current TxID.
+		if old := b.Get(nonceKey(nonce)); old != nil {
+			if err := b.Delete(txIDKey(TxID(old))); err != nil {
+				return err
+			}
+		}
+		if err := b.Put(nonceKey(nonce), []byte(txID)); err != nil {
+			return err
+		}
+		return b.Put(txIDKey(txID), nonceBytes(nonce))
+	})
+}
+
+func (s *boltNonceStore) GetByNonce(nonce uint64) (TxID, bool, error) {
+	var txID TxID
+	var found bool
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(boltNonceStoreBucket).Get(nonceKey(nonce))
+		if v == nil {
+			return nil
+		}
+		txID, found = TxID(v), true
+		return nil
+	})
+	return txID, found, err
+}
+
+func (s *boltNonceStore) GetByTxID(txID TxID) (uint64, bool, error) {
+	var nonce uint64
+	var found bool
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(boltNonceStoreBucket).Get(txIDKey(txID))
+		if v == nil {
+			return nil
+		}
+		if len(v) != 8 {
+			return errors.New("corrupt nonce value in bolt nonce store")
+		}
+		nonce, found = binary.BigEndian.Uint64(v), true
+		return nil
+	})
+	return nonce, found, err
+}
+
+func (s *boltNonceStore) Delete(txID TxID) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(boltNonceStoreBucket)
+		v := b.Get(txIDKey(txID))
+		if v != nil {
+			if err := b.Delete(nonceKey(binary.BigEndian.Uint64(v))); err != nil {
+				return err
+			}
+		}
+		return b.Delete(txIDKey(txID))
+	})
+}
+
+func nonceKey(nonce uint64) []byte {
+	return append([]byte("n:"), nonceBytes(nonce)...)
+}
+
+func txIDKey(txID TxID) []byte {
+	return append([]byte("t:"), []byte(txID)...)
+}
+
+func nonceBytes(nonce uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, nonce)
+	return b
+}
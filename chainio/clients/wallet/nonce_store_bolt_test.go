@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestBoltNonceStorePutDeleteConsistency covers the invariant every
+// NonceStore backend shares: GetByNonce and GetByTxID must always agree,
+// re-Put-ing a nonce with a new TxID must retire the old TxID's
+// reverse-index entry, and Delete must remove both sides of the mapping.
+func TestBoltNonceStorePutDeleteConsistency(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewBoltNonceStore(filepath.Join(dir, "nonce.db"))
+	if err != nil {
+		t.Fatalf("NewBoltNonceStore: %v", err)
+	}
+
+	if err := store.Put(5, "tx-a"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	gotTxID, found, err := store.GetByNonce(5)
+	if err != nil || !found || gotTxID != "tx-a" {
+		t.Fatalf("GetByNonce(5) = (%q, %v, %v), want (tx-a, true, nil)", gotTxID, found, err)
+	}
+	gotNonce, found, err := store.GetByTxID("tx-a")
+	if err != nil || !found || gotNonce != 5 {
+		t.Fatalf("GetByTxID(tx-a) = (%d, %v, %v), want (5, true, nil)", gotNonce, found, err)
+	}
+
+	// Re-Put the same nonce with a replacement TxID - the old TxID's
+	// reverse-index entry must no longer resolve.
+	if err := store.Put(5, "tx-b"); err != nil {
+		t.Fatalf("Put (replace): %v", err)
+	}
+	gotTxID, found, err = store.GetByNonce(5)
+	if err != nil || !found || gotTxID != "tx-b" {
+		t.Fatalf("GetByNonce(5) after replace = (%q, %v, %v), want (tx-b, true, nil)", gotTxID, found, err)
+	}
+	_, found, err = store.GetByTxID("tx-a")
+	if err != nil || found {
+		t.Fatalf("GetByTxID(tx-a) after replace = (_, %v, %v), want (_, false, nil)", found, err)
+	}
+
+	// Delete must remove both the nonce->txID and txID->nonce entries.
+	if err := store.Delete("tx-b"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	_, found, err = store.GetByNonce(5)
+	if err != nil || found {
+		t.Fatalf("GetByNonce(5) after delete = (_, %v, %v), want (_, false, nil)", found, err)
+	}
+	_, found, err = store.GetByTxID("tx-b")
+	if err != nil || found {
+		t.Fatalf("GetByTxID(tx-b) after delete = (_, %v, %v), want (_, false, nil)", found, err)
+	}
+
+	// Deleting an unknown TxID is a no-op, not an error.
+	if err := store.Delete("does-not-exist"); err != nil {
+		t.Fatalf("Delete(unknown): %v", err)
+	}
+}
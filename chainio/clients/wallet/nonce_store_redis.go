@@ -0,0 +1,100 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisNonceStore is a NonceStore backed by Redis, for operators running
+// multiple wallet processes (or pods that don't share a local disk)
+// against one shared nonce/TxID index. Keys are namespaced under prefix
+// so one Redis instance can back several wallets.
+type redisNonceStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisNonceStore returns a NonceStore backed by client, namespacing
+// all of its keys under prefix.
+func NewRedisNonceStore(client *redis.Client, prefix string) NonceStore {
+	return &redisNonceStore{client: client, prefix: prefix}
+}
+
+func (s *redisNonceStore) nonceKey(nonce uint64) string {
+	return fmt.Sprintf("%s:n:%d", s.prefix, nonce)
+}
+
+func (s *redisNonceStore) txIDKey(txID TxID) string {
+	return fmt.Sprintf("%s:t:%s", s.prefix, txID)
+}
+
+func (s *redisNonceStore) Put(nonce uint64, txID TxID) error {
+	ctx := context.Background()
+	// A nonce's previously-stored TxID, if any, is being replaced - its
+	// reverse-index entry would otherwise keep resolving to nonce even
+	// though it's no longer nonce's current TxID.
+	old, err := s.client.Get(ctx, s.nonceKey(nonce)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("error reading nonce %d: %w", nonce, err)
+	}
+	if err == nil {
+		if err := s.client.Del(ctx, s.txIDKey(TxID(old))).Err(); err != nil {
+			return fmt.Errorf("error deleting stale txID %s: %w", old, err)
+		}
+	}
+	if err := s.client.Set(ctx, s.nonceKey(nonce), string(txID), 0).Err(); err != nil {
+		return fmt.Errorf("error storing nonce %d: %w", nonce, err)
+	}
+	if err := s.client.Set(ctx, s.txIDKey(txID), strconv.FormatUint(nonce, 10), 0).Err(); err != nil {
+		return fmt.Errorf("error storing txID %s: %w", txID, err)
+	}
+	return nil
+}
+
+func (s *redisNonceStore) GetByNonce(nonce uint64) (TxID, bool, error) {
+	v, err := s.client.Get(context.Background(), s.nonceKey(nonce)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("error getting nonce %d: %w", nonce, err)
+	}
+	return TxID(v), true, nil
+}
+
+func (s *redisNonceStore) GetByTxID(txID TxID) (uint64, bool, error) {
+	v, err := s.client.Get(context.Background(), s.txIDKey(txID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("error getting txID %s: %w", txID, err)
+	}
+	nonce, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("corrupt nonce value for txID %s: %w", txID, err)
+	}
+	return nonce, true, nil
+}
+
+func (s *redisNonceStore) Delete(txID TxID) error {
+	ctx := context.Background()
+	nonce, found, err := s.GetByTxID(txID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	if err := s.client.Del(ctx, s.nonceKey(nonce)).Err(); err != nil {
+		return fmt.Errorf("error deleting nonce %d: %w", nonce, err)
+	}
+	if err := s.client.Del(ctx, s.txIDKey(txID)).Err(); err != nil {
+		return fmt.Errorf("error deleting txID %s: %w", txID, err)
+	}
+	return nil
+}
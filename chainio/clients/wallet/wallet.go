@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TxID identifies a transaction submitted through a Wallet. It's opaque on
+// purpose: a custody backend like Fireblocks assigns its own transaction
+// ID well before (and independently of) the eventual on-chain transaction
+// hash, so callers must go through GetTransactionReceipt rather than
+// treating TxID as a common.Hash.
+type TxID string
+
+// PendingTx describes a transaction a Wallet still considers in flight:
+// submitted, but not yet resolved via GetTransactionReceipt.
+type PendingTx struct {
+	Nonce uint64
+	TxID  TxID
+	// Submitted is when the transaction currently tracked as TxID was
+	// first submitted. It's the zero Time if a backend doesn't track
+	// submission times.
+	Submitted time.Time
+}
+
+// Wallet submits transactions through whatever signing or custody backend
+// it wraps - a local private key, Fireblocks, a remote KMS - hiding the
+// backend-specific submission and status-polling details behind one
+// interface, so callers can swap backends by swapping the constructor
+// passed to chainio/clients/wallet.
+type Wallet interface {
+	// SendTransaction submits tx and returns a TxID for tracking its
+	// status via GetTransactionReceipt. Calling SendTransaction again
+	// with a transaction that reuses an in-flight nonce replaces the
+	// earlier submission rather than duplicating it.
+	SendTransaction(ctx context.Context, tx *types.Transaction) (TxID, error)
+	// SendTransactionBatch submits each of txs in order via
+	// SendTransaction, stopping at (and returning) the first error along
+	// with the TxIDs of the calls that succeeded before it.
+	SendTransactionBatch(ctx context.Context, txs []*types.Transaction) ([]TxID, error)
+	// GetTransactionReceipt returns the on-chain receipt for txID, or an
+	// error wrapping ErrNotYetBroadcasted / ErrReceiptNotYetAvailable
+	// while it's still in flight.
+	GetTransactionReceipt(ctx context.Context, txID TxID) (*types.Receipt, error)
+	// SenderAddress returns the Ethereum address transactions are sent
+	// from.
+	SenderAddress(ctx context.Context) (common.Address, error)
+	// PendingTransactions returns the transactions the wallet still
+	// considers in flight, ordered by nonce.
+	PendingTransactions() []PendingTx
+}
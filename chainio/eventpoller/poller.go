@@ -0,0 +1,50 @@
+// Package eventpoller lets the generated *Filterer.Watch* methods - which
+// call bind.ContractBackend.SubscribeFilterLogs, and so need an
+// eth_subscribe-capable transport - run over a plain HTTPS JSON-RPC
+// endpoint instead. NewPollingBackend wraps any bind.ContractBackend and
+// answers SubscribeFilterLogs with periodic eth_getLogs polling, so it
+// can be passed straight to NewContractIRewardsCoordinatorFilterer (or
+// any other generated filterer) in place of a WebSocket client.
+package eventpoller
+
+import (
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// PollConfig controls how a PollingBackend paginates and buffers logs.
+type PollConfig struct {
+	// Interval is how often a subscription polls for new logs.
+	Interval time.Duration
+	// Confirmations is how many blocks behind the current head a block
+	// must be before its logs are delivered. Delivered blocks are
+	// re-checked for reorgs until they fall Confirmations*4+64 blocks
+	// behind that point.
+	Confirmations uint64
+	// ChunkSize is the initial number of blocks requested per
+	// eth_getLogs call. It's halved automatically (down to a floor of 1)
+	// whenever the RPC reports the range as too large.
+	ChunkSize uint64
+}
+
+// PollingBackend wraps a bind.ContractBackend, passing every method
+// through to it unchanged except SubscribeFilterLogs, which it answers
+// with polling instead of a subscription.
+type PollingBackend struct {
+	bind.ContractBackend
+	config PollConfig
+	logger logging.Logger
+}
+
+// NewPollingBackend returns a PollingBackend reading from client.
+func NewPollingBackend(client bind.ContractBackend, config PollConfig, logger logging.Logger) *PollingBackend {
+	if config.Interval == 0 {
+		config.Interval = 15 * time.Second
+	}
+	if config.ChunkSize == 0 {
+		config.ChunkSize = 10_000
+	}
+	return &PollingBackend{ContractBackend: client, config: config, logger: logger}
+}
@@ -0,0 +1,244 @@
+package eventpoller
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+)
+
+// logKey identifies a log for deduplication across overlapping polls.
+type logKey struct {
+	blockHash common.Hash
+	txIndex   uint
+	logIndex  uint
+}
+
+func keyOf(log types.Log) logKey {
+	return logKey{blockHash: log.BlockHash, txIndex: log.TxIndex, logIndex: log.Index}
+}
+
+// deliveredBlock tracks one block's canonical hash and the logs delivered
+// for it, at the time of delivery, so a later poll can detect the block
+// was reorged out and re-emit its logs with Removed set.
+type deliveredBlock struct {
+	hash common.Hash
+	logs []types.Log
+}
+
+// pollSubscription implements ethereum.Subscription by polling query via
+// FilterLogs on an interval instead of holding an eth_subscribe stream
+// open.
+type pollSubscription struct {
+	cancel context.CancelFunc
+	errCh  chan error
+}
+
+func (s *pollSubscription) Unsubscribe() {
+	s.cancel()
+}
+
+func (s *pollSubscription) Err() <-chan error {
+	return s.errCh
+}
+
+// SubscribeFilterLogs polls query on p.config.Interval instead of opening
+// an eth_subscribe stream, delivering matching logs to ch in the same
+// shape go-ethereum's native subscription would: ordered, deduplicated,
+// and with Removed=true re-deliveries when a previously delivered log's
+// block is orphaned by a reorg.
+func (p *PollingBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	sub := &pollSubscription{cancel: cancel, errCh: make(chan error, 1)}
+
+	go func() {
+		defer close(sub.errCh)
+		if err := p.run(runCtx, query, ch); err != nil && !errors.Is(err, context.Canceled) {
+			select {
+			case sub.errCh <- err:
+			default:
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+func (p *PollingBackend) run(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) error {
+	next, err := p.startBlock(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[logKey]bool)
+	delivered := make(map[uint64]*deliveredBlock)
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		head, err := p.ContractBackend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return err
+		}
+		headNumber := head.Number.Uint64()
+
+		rewind, reorged, err := p.checkReorgs(ctx, delivered, ch)
+		if err != nil {
+			return err
+		}
+		if reorged && rewind < next {
+			next = rewind
+		}
+
+		safe := uint64(0)
+		if headNumber > p.config.Confirmations {
+			safe = headNumber - p.config.Confirmations
+		}
+		if query.ToBlock != nil && query.ToBlock.Uint64() < safe {
+			safe = query.ToBlock.Uint64()
+		}
+
+		if next <= safe {
+			newNext, err := p.deliverRange(ctx, query, next, safe, seen, delivered, ch)
+			if err != nil {
+				return err
+			}
+			next = newNext
+			pruneDelivered(delivered, safe, p.config.Confirmations)
+		}
+
+		if query.ToBlock != nil && next > query.ToBlock.Uint64() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// startBlock resolves where polling begins: query.FromBlock if set, else
+// the current head.
+func (p *PollingBackend) startBlock(ctx context.Context, query ethereum.FilterQuery) (uint64, error) {
+	if query.FromBlock != nil {
+		return query.FromBlock.Uint64(), nil
+	}
+	head, err := p.ContractBackend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return head.Number.Uint64(), nil
+}
+
+// deliverRange pages [from, to] in p.config.ChunkSize windows, halving on
+// a too-large-range error, delivering every not-yet-seen log in order and
+// recording it in delivered for later reorg checks. It returns the next
+// unprocessed block number.
+func (p *PollingBackend) deliverRange(ctx context.Context, query ethereum.FilterQuery, from, to uint64, seen map[logKey]bool, delivered map[uint64]*deliveredBlock, ch chan<- types.Log) (uint64, error) {
+	chunk := p.config.ChunkSize
+	cursor := from
+
+	for cursor <= to {
+		end := cursor + chunk - 1
+		if end > to {
+			end = to
+		}
+
+		q := query
+		q.FromBlock = new(big.Int).SetUint64(cursor)
+		q.ToBlock = new(big.Int).SetUint64(end)
+
+		logs, err := p.ContractBackend.FilterLogs(ctx, q)
+		if err != nil {
+			if rpcerrors.IsRangeTooLarge(err) && chunk > 1 {
+				chunk /= 2
+				if chunk == 0 {
+					chunk = 1
+				}
+				continue
+			}
+			return cursor, err
+		}
+
+		for _, log := range logs {
+			key := keyOf(log)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			db, ok := delivered[log.BlockNumber]
+			if !ok {
+				db = &deliveredBlock{hash: log.BlockHash}
+				delivered[log.BlockNumber] = db
+			}
+			db.logs = append(db.logs, log)
+
+			select {
+			case ch <- log:
+			case <-ctx.Done():
+				return cursor, ctx.Err()
+			}
+		}
+
+		cursor = end + 1
+	}
+	return cursor, nil
+}
+
+// checkReorgs re-verifies every delivered block's canonical hash. A
+// mismatch means the block was orphaned: every log previously delivered
+// for it is re-emitted with Removed=true, and the entry is dropped so the
+// block's logs can be redelivered under its new hash once it's included
+// again. It returns the lowest orphaned block number and true if any block
+// was orphaned, so the caller can resume polling from there.
+func (p *PollingBackend) checkReorgs(ctx context.Context, delivered map[uint64]*deliveredBlock, ch chan<- types.Log) (uint64, bool, error) {
+	lowest, reorged := uint64(0), false
+	for blockNumber, db := range delivered {
+		header, err := p.ContractBackend.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+		if err != nil {
+			continue
+		}
+		if header.Hash() == db.hash {
+			continue
+		}
+		for _, log := range db.logs {
+			removed := log
+			removed.Removed = true
+			select {
+			case ch <- removed:
+			case <-ctx.Done():
+				return 0, false, ctx.Err()
+			}
+		}
+		delete(delivered, blockNumber)
+		if !reorged || blockNumber < lowest {
+			lowest, reorged = blockNumber, true
+		}
+	}
+	return lowest, reorged, nil
+}
+
+// pruneDelivered drops delivered-block bookkeeping once it's far enough
+// behind safe that a reorg reaching it is no longer plausible.
+func pruneDelivered(delivered map[uint64]*deliveredBlock, safe, confirmations uint64) {
+	depth := confirmations*4 + 64
+	if safe <= depth {
+		return
+	}
+	floor := safe - depth
+	for blockNumber := range delivered {
+		if blockNumber < floor {
+			delete(delivered, blockNumber)
+		}
+	}
+}
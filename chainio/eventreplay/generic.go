@@ -0,0 +1,133 @@
+package eventreplay
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+)
+
+// LogIterator walks a replayed event's raw logs in (blockNumber,
+// logIndex) order, mirroring the Next()/Event/Error()/Close() shape of a
+// generated Filter*Iterator so callers already used to that convention
+// can treat this the same way. Decoding Event into a typed struct is left
+// to the caller, since ReplayByEventID only knows the event's topic, not
+// its ABI.
+type LogIterator struct {
+	logs []types.Log
+	pos  int
+	// Event is the current log, valid after Next returns true.
+	Event types.Log
+}
+
+// Next advances the iterator. It returns false once every log has been
+// visited.
+func (it *LogIterator) Next() bool {
+	if it.pos >= len(it.logs) {
+		return false
+	}
+	it.Event = it.logs[it.pos]
+	it.pos++
+	return true
+}
+
+// Error always returns nil: ReplayByEventID surfaces fetch errors
+// directly from its return value, not through the iterator.
+func (it *LogIterator) Error() error { return nil }
+
+// Close is a no-op, provided for Filter*Iterator shape parity.
+func (it *LogIterator) Close() error { return nil }
+
+// ReplayByEventID replays every log matching eventID - a Solidity event's
+// topic0, found in the doc comment above its generated Filter*/Watch*
+// pair (e.g. "binding the contract event 0x2373...") - and optional extra
+// topics (for indexed parameters) emitted by addr between fromBlock and
+// toBlock, across however many chunks config.MaxBlockRange requires. This
+// is the event-agnostic path every typed ReplayXxx helper in this package
+// is built on; use it directly for RewardsCoordinator events (or any
+// other contract's events) this package doesn't have a typed wrapper for.
+func ReplayByEventID(ctx context.Context, filterer bind.ContractFilterer, addr common.Address, eventID common.Hash, topics [][]common.Hash, fromBlock, toBlock uint64, config ReplayConfig) (*LogIterator, error) {
+	config = config.withDefaults()
+
+	queryTopics := append([][]common.Hash{{eventID}}, topics...)
+
+	var all []types.Log
+	size := config.MaxBlockRange
+	for _, window := range chunks(fromBlock, toBlock, size) {
+		logs, newSize, err := fetchChunkWithRetry(ctx, filterer, addr, queryTopics, window[0], window[1], size, config)
+		if err != nil {
+			return nil, err
+		}
+		size = newSize
+		all = append(all, logs...)
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].BlockNumber != all[j].BlockNumber {
+			return all[i].BlockNumber < all[j].BlockNumber
+		}
+		return all[i].Index < all[j].Index
+	})
+
+	return &LogIterator{logs: all}, nil
+}
+
+// fetchChunkWithRetry fetches [from, to], bisecting into narrower windows
+// (by halving size and re-chunking) on a too-large-range error, and
+// retrying the same window with exponential backoff on a rate-limit
+// error. It returns the chunk size that ended up working, so the caller
+// can reuse it as a starting point for the next window instead of
+// re-discovering it from scratch every time.
+func fetchChunkWithRetry(ctx context.Context, filterer bind.ContractFilterer, addr common.Address, topics [][]common.Hash, from, to, size uint64, config ReplayConfig) ([]types.Log, uint64, error) {
+	backoff := config.MinBackoff
+
+	for attempt := 0; ; attempt++ {
+		query := ethereum.FilterQuery{
+			Addresses: []common.Address{addr},
+			Topics:    topics,
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+		}
+		logs, err := filterer.FilterLogs(ctx, query)
+		if err == nil {
+			return logs, size, nil
+		}
+
+		if rpcerrors.IsRangeTooLarge(err) && size > 1 {
+			newSize := size / 2
+			if newSize == 0 {
+				newSize = 1
+			}
+			var all []types.Log
+			for _, window := range chunks(from, to, newSize) {
+				sub, resultSize, err := fetchChunkWithRetry(ctx, filterer, addr, topics, window[0], window[1], newSize, config)
+				if err != nil {
+					return nil, resultSize, err
+				}
+				newSize = resultSize
+				all = append(all, sub...)
+			}
+			return all, newSize, nil
+		}
+
+		if isRateLimitedError(err) && attempt < config.MaxRetries {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, size, ctx.Err()
+			}
+			backoff = nextBackoff(backoff, config.MaxBackoff)
+			continue
+		}
+
+		return nil, size, fmt.Errorf("error filtering logs for blocks [%d, %d]: %w", from, to, err)
+	}
+}
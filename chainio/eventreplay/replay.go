@@ -0,0 +1,84 @@
+// Package eventreplay replays a single RewardsCoordinator event across a
+// block range too large for one FilterLogs call. It pages the range in
+// adaptive chunks - starting at ReplayConfig.MaxBlockRange, halving
+// whenever the RPC rejects a chunk as too large, and backing off
+// exponentially on rate limiting - then stitches the per-chunk results
+// back into one ordered stream, so a caller never has to reason about
+// chunk boundaries itself.
+package eventreplay
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// ReplayConfig controls how a replay paginates and retries.
+type ReplayConfig struct {
+	// MaxBlockRange is the initial number of blocks requested per
+	// getLogs call. It's halved (down to a floor of 1) whenever the RPC
+	// reports the range as too large.
+	MaxBlockRange uint64
+	// MaxRetries bounds how many times a single chunk is retried after a
+	// rate-limit response before the replay gives up and returns an
+	// error.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the exponential-with-jitter delay
+	// between retries of a rate-limited chunk.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func (c *ReplayConfig) withDefaults() ReplayConfig {
+	out := *c
+	if out.MaxBlockRange == 0 {
+		out.MaxBlockRange = 5_000
+	}
+	if out.MaxRetries == 0 {
+		out.MaxRetries = 8
+	}
+	if out.MinBackoff == 0 {
+		out.MinBackoff = time.Second
+	}
+	if out.MaxBackoff == 0 {
+		out.MaxBackoff = time.Minute
+	}
+	return out
+}
+
+// chunks splits [from, to] into contiguous, inclusive windows of at most
+// size blocks each.
+func chunks(from, to, size uint64) [][2]uint64 {
+	if size == 0 {
+		size = 1
+	}
+	var out [][2]uint64
+	for cursor := from; cursor <= to; {
+		end := cursor + size - 1
+		if end > to || end < cursor {
+			end = to
+		}
+		out = append(out, [2]uint64{cursor, end})
+		cursor = end + 1
+		if cursor == 0 {
+			break
+		}
+	}
+	return out
+}
+
+func nextBackoff(current, max time.Duration) time.Duration {
+	next := current * 2
+	if next > max {
+		next = max
+	}
+	jitter := time.Duration(rand.Int63n(int64(next)/4 + 1))
+	return next + jitter
+}
+
+// isRateLimitedError reports whether err looks like an RPC provider's
+// 429/Too Many Requests response.
+func isRateLimitedError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "429") || strings.Contains(msg, "too many requests") || strings.Contains(msg, "rate limit")
+}
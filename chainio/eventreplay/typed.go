@@ -0,0 +1,118 @@
+package eventreplay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// rewardsUpdaterSetFilterer is the one binding method
+// ReplayRewardsUpdaterSet needs.
+type rewardsUpdaterSetFilterer interface {
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+}
+
+// ReplayFilterOpts carries the indexed-parameter filter arguments a
+// generated Filter* method accepts. Its Context field is not read:
+// ReplayRewardsUpdaterSet cancels on its own ctx argument.
+type ReplayFilterOpts struct {
+	OldRewardsUpdater []common.Address
+	NewRewardsUpdater []common.Address
+	Context           context.Context
+}
+
+// ReplayRewardsUpdaterSet replays every RewardsUpdaterSet event between
+// start and end, chunking the range per config and sending each event to
+// sink in (blockNumber, logIndex) order. Unlike ReplayByEventID, it reads
+// through the binding's own FilterRewardsUpdaterSet on every chunk, so
+// events arrive already decoded.
+func ReplayRewardsUpdaterSet(ctx context.Context, filterer rewardsUpdaterSetFilterer, start, end uint64, filterOpts ReplayFilterOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, config ReplayConfig) error {
+	config = config.withDefaults()
+
+	events, err := replayRewardsUpdaterSetChunk(ctx, filterer, start, end, config.MaxBlockRange, filterOpts, config)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Raw.BlockNumber != events[j].Raw.BlockNumber {
+			return events[i].Raw.BlockNumber < events[j].Raw.BlockNumber
+		}
+		return events[i].Raw.Index < events[j].Raw.Index
+	})
+
+	for _, ev := range events {
+		select {
+		case sink <- ev:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// replayRewardsUpdaterSetChunk pages [from, to] in windows of size
+// blocks, halving size (recursively re-chunking) on a too-large-range
+// error and retrying a rate-limited chunk with exponential backoff.
+func replayRewardsUpdaterSetChunk(ctx context.Context, filterer rewardsUpdaterSetFilterer, from, to, size uint64, filterOpts ReplayFilterOpts, config ReplayConfig) ([]*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, error) {
+	var all []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet
+
+	for _, window := range chunks(from, to, size) {
+		events, newSize, err := fetchRewardsUpdaterSetWindow(ctx, filterer, window[0], window[1], size, filterOpts, config)
+		if err != nil {
+			return nil, err
+		}
+		size = newSize
+		all = append(all, events...)
+	}
+	return all, nil
+}
+
+func fetchRewardsUpdaterSetWindow(ctx context.Context, filterer rewardsUpdaterSetFilterer, from, to, size uint64, filterOpts ReplayFilterOpts, config ReplayConfig) ([]*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, uint64, error) {
+	backoff := config.MinBackoff
+
+	for attempt := 0; ; attempt++ {
+		opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+		it, err := filterer.FilterRewardsUpdaterSet(opts, filterOpts.OldRewardsUpdater, filterOpts.NewRewardsUpdater)
+		if err == nil {
+			var events []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet
+			for it.Next() {
+				events = append(events, it.Event)
+			}
+			iterErr := it.Error()
+			it.Close()
+			if iterErr == nil {
+				return events, size, nil
+			}
+			err = iterErr
+		}
+
+		if rpcerrors.IsRangeTooLarge(err) && size > 1 {
+			newSize := size / 2
+			if newSize == 0 {
+				newSize = 1
+			}
+			events, err := replayRewardsUpdaterSetChunk(ctx, filterer, from, to, newSize, filterOpts, config)
+			return events, newSize, err
+		}
+
+		if isRateLimitedError(err) && attempt < config.MaxRetries {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, size, ctx.Err()
+			}
+			backoff = nextBackoff(backoff, config.MaxBackoff)
+			continue
+		}
+
+		return nil, size, fmt.Errorf("error filtering RewardsUpdaterSet for blocks [%d, %d]: %w", from, to, err)
+	}
+}
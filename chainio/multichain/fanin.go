@@ -0,0 +1,53 @@
+package multichain
+
+import (
+	"context"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// ChainSource is one chain's RewardsCoordinator filterer, its HeadFunc for
+// resolving confirmation depth, and its ChainConfig.
+type ChainSource struct {
+	Filterer eventFilterer
+	HeadFunc func(ctx context.Context) (uint64, error)
+	Config   ChainConfig
+}
+
+// Fanin merges several chains' RewardsCoordinator watchers into a single
+// channel of chain-tagged events.
+type Fanin struct {
+	sources map[uint64]ChainSource
+	logger  logging.Logger
+}
+
+// New returns a Fanin watching every chain in sources, keyed by chain ID.
+func New(sources map[uint64]ChainSource, logger logging.Logger) *Fanin {
+	return &Fanin{sources: sources, logger: logger}
+}
+
+// Run starts one independent watcher per chain and returns a channel
+// carrying every chain's events, merged in arrival order. The channel is
+// closed once ctx is canceled and every watcher has exited.
+func (f *Fanin) Run(ctx context.Context) <-chan Event {
+	out := make(chan Event, 256)
+
+	done := make(chan struct{}, len(f.sources))
+	for chainID, source := range f.sources {
+		w := newChainWatcher(chainID, source.Filterer, source.HeadFunc, source.Config, f.logger)
+		fromBlock := source.Config.FromBlock
+		go func() {
+			w.run(ctx, fromBlock, out)
+			done <- struct{}{}
+		}()
+	}
+
+	go func() {
+		for range f.sources {
+			<-done
+		}
+		close(out)
+	}()
+
+	return out
+}
@@ -0,0 +1,84 @@
+// Package multichain fans in RewardsCoordinator events from several
+// per-chain contract bindings - one per L2 rollup deployment, typically -
+// into a single ordered channel tagged with the originating chain, so an
+// AVS operator watching RewardsCoordinator across Optimism, Base,
+// Arbitrum, and friends doesn't have to hand-roll one goroutine and one
+// reconnect loop per chain. Each chain runs its own
+// chainio/subscriber/rewardscoordinator.Subscriber, so a stalled RPC on
+// one chain never blocks delivery from the others, and reorgs are
+// handled by that Subscriber rather than reimplemented here.
+package multichain
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// Event is the tagged-union element Fanin delivers. Exactly one of
+// DistributionRootSubmitted or OperatorAVSSplitBipsSet is non-nil.
+// Reverted is set when this event's block is no longer canonical on its
+// chain; consumers should retract whatever they previously recorded for
+// it rather than treat this as a new occurrence.
+type Event struct {
+	ChainID     uint64
+	BlockNumber uint64
+	BlockHash   common.Hash
+	Reverted    bool
+
+	DistributionRootSubmitted *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted
+	OperatorAVSSplitBipsSet   *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet
+}
+
+// eventFilterer is the full RewardsCoordinator binding surface, identical
+// to rewardscoordinator's own (unexported) eventFilterer - redeclared
+// here so newChainWatcher can name it without depending on that
+// package's internals. A chain's real generated filterer binding
+// satisfies this even though Fanin only surfaces two of its events.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
@@ -0,0 +1,85 @@
+package multichain
+
+import (
+	"context"
+	"fmt"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// ChainConfig customizes one chain's watcher.
+type ChainConfig struct {
+	// FromBlock is the first block that chain's watcher backfills from.
+	FromBlock  uint64
+	Subscriber rewardscoordinator.Config
+}
+
+// chainWatcher narrows one chain's rewardscoordinator.Subscriber stream
+// down to the two events Fanin tags and forwards, and stamps each one
+// with this chain's ID.
+type chainWatcher struct {
+	chainID uint64
+	sub     *rewardscoordinator.Subscriber
+	logger  logging.Logger
+}
+
+func newChainWatcher(chainID uint64, filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), config ChainConfig, logger logging.Logger) *chainWatcher {
+	return &chainWatcher{
+		chainID: chainID,
+		sub:     rewardscoordinator.New(filterer, headFunc, config.Subscriber, logger),
+		logger:  logger,
+	}
+}
+
+// run subscribes from fromBlock and forwards mapped events to out until
+// ctx is canceled. The underlying Subscriber handles reconnect, backfill
+// and reorg-safe delivery; a stalled RPC on this chain only blocks this
+// goroutine, never the other chains' watchers.
+func (w *chainWatcher) run(ctx context.Context, fromBlock uint64, out chan<- Event) {
+	raw := make(chan rewardscoordinator.RewardsCoordinatorEvent)
+	sub, err := w.sub.Subscribe(ctx, fromBlock, raw)
+	if err != nil {
+		w.logger.Error("multichain watcher failed to subscribe", "chainId", w.chainID, "err", err)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err, ok := <-sub.Err():
+			if ok && err != nil {
+				w.logger.Error("multichain watcher subscription ended", "chainId", w.chainID, "err", fmt.Errorf("chain %d: %w", w.chainID, err))
+			}
+			return
+		case ev, ok := <-raw:
+			if !ok {
+				return
+			}
+			if mapped, ok := w.mapEvent(ev); ok {
+				select {
+				case out <- mapped:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// mapEvent translates a RewardsCoordinatorEvent into a chain-tagged
+// Event, if its Kind is one of the two this package tracks.
+func (w *chainWatcher) mapEvent(ev rewardscoordinator.RewardsCoordinatorEvent) (Event, bool) {
+	out := Event{ChainID: w.chainID, BlockNumber: ev.BlockNumber, BlockHash: ev.BlockHash, Reverted: ev.Reverted}
+	switch ev.Kind {
+	case rewardscoordinator.KindDistributionRootSubmitted:
+		out.DistributionRootSubmitted = ev.DistributionRootSubmitted
+	case rewardscoordinator.KindOperatorAVSSplitBipsSet:
+		out.OperatorAVSSplitBipsSet = ev.OperatorAVSSplitBipsSet
+	default:
+		return Event{}, false
+	}
+	return out, true
+}
@@ -0,0 +1,78 @@
+package historical
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// CheckpointStore persists the last fully-scanned block per event topic,
+// so a restarted Scan resumes instead of rescanning from fromBlock.
+type CheckpointStore interface {
+	LoadCheckpoint(ctx context.Context, eventName string) (block uint64, ok bool, err error)
+	SaveCheckpoint(ctx context.Context, eventName string, block uint64) error
+}
+
+// FileCheckpointStore is a CheckpointStore backed by a single JSON file on
+// disk, so a long-running backfill survives process restarts without
+// requiring callers to stand up a database just to track scan progress.
+type FileCheckpointStore struct {
+	path string
+
+	mu sync.Mutex
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore persisting to path.
+// path doesn't need to exist yet; it's created on the first
+// SaveCheckpoint.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// LoadCheckpoint implements CheckpointStore.
+func (f *FileCheckpointStore) LoadCheckpoint(ctx context.Context, eventName string) (uint64, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	checkpoints, err := f.read()
+	if err != nil {
+		return 0, false, err
+	}
+	block, ok := checkpoints[eventName]
+	return block, ok, nil
+}
+
+// SaveCheckpoint implements CheckpointStore.
+func (f *FileCheckpointStore) SaveCheckpoint(ctx context.Context, eventName string, block uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	checkpoints, err := f.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[eventName] = block
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding checkpoints for %s: %w", f.path, err)
+	}
+	if err := os.WriteFile(f.path, data, 0o644); err != nil {
+		return fmt.Errorf("error writing checkpoints to %s: %w", f.path, err)
+	}
+	return nil
+}
+
+func (f *FileCheckpointStore) read() (map[string]uint64, error) {
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return make(map[string]uint64), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoints from %s: %w", f.path, err)
+	}
+	checkpoints := make(map[string]uint64)
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("error decoding checkpoints from %s: %w", f.path, err)
+	}
+	return checkpoints, nil
+}
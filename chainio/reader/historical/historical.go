@@ -0,0 +1,149 @@
+// Package historical pages through the three RewardsCoordinator events
+// RewardsReader and the events package don't already cover -
+// RewardsForAllSubmitterSet, RewardsSubmissionForAllCreated, and
+// RewardsSubmissionForAllEarnersCreated - in a way that survives the
+// block/result caps public RPC providers (Alchemy, Infura, QuickNode,
+// Ankr, ...) enforce on eth_getLogs, which otherwise makes scanning
+// either event's full history unworkable. A Scan splits [fromBlock,
+// toBlock] into Config.PageSize windows and fetches up to
+// Config.Concurrency of them at once; a window an RPC rejects as too
+// large is bisected in half (recursively, down to a single block)
+// instead of retried at some guessed-smaller fixed size. Results are
+// merged back into block order and exposed through an iterator shaped
+// like the generated Filter*Iterator types, so callers can swap a Scan in
+// wherever they'd call FilterRewardsForAllSubmitterSet (etc.) directly.
+package historical
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// eventFilterer is the subset of the RewardsCoordinator binding Scanner
+// pages through.
+type eventFilterer interface {
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+}
+
+// Config controls a Scanner's paging, concurrency, and persistence.
+type Config struct {
+	// PageSize is the initial window size, in blocks, a Scan requests
+	// per getLogs call. Defaults to 5,000.
+	PageSize uint64
+	// Concurrency is how many windows a Scan fetches at once. Defaults
+	// to 4.
+	Concurrency int
+	// Checkpoints, if non-nil, lets a Scan resume from the last fully
+	// scanned block for its event instead of rescanning from fromBlock.
+	Checkpoints CheckpointStore
+	// Sink, if non-nil, receives every event a Scan reads, in addition
+	// to (not instead of) the iterator it returns.
+	Sink   Sink
+	Logger logging.Logger
+}
+
+// Scanner pages through RewardsForAllSubmitterSet,
+// RewardsSubmissionForAllCreated, and RewardsSubmissionForAllEarnersCreated
+// using a bounded worker pool and recursive bisection on oversized
+// ranges.
+type Scanner struct {
+	filterer eventFilterer
+	config   Config
+}
+
+// NewScanner returns a Scanner reading from filterer.
+func NewScanner(filterer eventFilterer, config Config) *Scanner {
+	if config.PageSize == 0 {
+		config.PageSize = 5_000
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = 4
+	}
+	return &Scanner{filterer: filterer, config: config}
+}
+
+// window is a single [from, to] block range assigned to one worker.
+type window struct {
+	from, to uint64
+}
+
+// splitWindows divides [from, to] into pageSize-sized windows.
+func splitWindows(from, to, pageSize uint64) []window {
+	if pageSize == 0 {
+		pageSize = to - from + 1
+	}
+	var windows []window
+	for from <= to {
+		end := from + pageSize - 1
+		if end > to {
+			end = to
+		}
+		windows = append(windows, window{from: from, to: end})
+		from = end + 1
+	}
+	return windows
+}
+
+// runWindows calls fetch once per window, running up to concurrency of
+// them at a time, and waits for every window to finish before returning
+// the first error encountered, if any.
+func runWindows(ctx context.Context, windows []window, concurrency int, fetch func(ctx context.Context, w window) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+
+	for _, w := range windows {
+		w := w
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fetch(ctx, w); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// resumeFrom returns fromBlock, or the block after eventName's saved
+// checkpoint if that's later, so a Scan never rescans blocks a previous
+// Scan already finished.
+func resumeFrom(ctx context.Context, checkpoints CheckpointStore, eventName string, fromBlock uint64) (uint64, error) {
+	if checkpoints == nil {
+		return fromBlock, nil
+	}
+	checkpoint, ok, err := checkpoints.LoadCheckpoint(ctx, eventName)
+	if err != nil {
+		return 0, fmt.Errorf("error loading checkpoint for %s: %w", eventName, err)
+	}
+	if ok && checkpoint+1 > fromBlock {
+		return checkpoint + 1, nil
+	}
+	return fromBlock, nil
+}
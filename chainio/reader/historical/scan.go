@@ -0,0 +1,303 @@
+package historical
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// RewardsForAllSubmitterSetIterator walks a Scan's merged, block-ordered
+// results. It's shaped like the generated
+// ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator so callers
+// can use it the same way: call Next until it returns false, reading
+// Event after each true result.
+type RewardsForAllSubmitterSetIterator struct {
+	events []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet
+	pos    int
+	Event  *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet
+}
+
+// Next advances the iterator, reporting whether another event is
+// available.
+func (it *RewardsForAllSubmitterSetIterator) Next() bool {
+	if it.pos >= len(it.events) {
+		return false
+	}
+	it.Event = it.events[it.pos]
+	it.pos++
+	return true
+}
+
+// Error always returns nil: a Scan surfaces any fetch error directly,
+// rather than deferring it to the iterator the way the generated
+// FilterLogs iterators do.
+func (it *RewardsForAllSubmitterSetIterator) Error() error { return nil }
+
+// Close is a no-op: unlike the generated iterators, this one doesn't hold
+// an open subscription.
+func (it *RewardsForAllSubmitterSetIterator) Close() error { return nil }
+
+// ScanRewardsForAllSubmitterSet returns every RewardsForAllSubmitterSet
+// event in [fromBlock, toBlock] (or from Config.Checkpoints' saved
+// checkpoint, if later), fetching Config.PageSize-sized windows
+// Config.Concurrency at a time.
+func (s *Scanner) ScanRewardsForAllSubmitterSet(ctx context.Context, fromBlock, toBlock uint64) (*RewardsForAllSubmitterSetIterator, error) {
+	const name = "RewardsForAllSubmitterSet"
+	from, err := resumeFrom(ctx, s.config.Checkpoints, name, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > toBlock {
+		return &RewardsForAllSubmitterSetIterator{}, nil
+	}
+
+	var mu sync.Mutex
+	var events []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet
+	var fetch func(ctx context.Context, w window) error
+	fetch = func(ctx context.Context, w window) error {
+		it, err := s.filterer.FilterRewardsForAllSubmitterSet(&bind.FilterOpts{Start: w.from, End: &w.to, Context: ctx}, nil, nil, nil)
+		if err != nil {
+			if rpcerrors.IsRangeTooLarge(err) && w.from < w.to {
+				mid := w.from + (w.to-w.from)/2
+				if s.config.Logger != nil {
+					s.config.Logger.Info("getLogs range rejected as too large, bisecting", "event", name, "from", w.from, "to", w.to)
+				}
+				if err := fetch(ctx, window{from: w.from, to: mid}); err != nil {
+					return err
+				}
+				return fetch(ctx, window{from: mid + 1, to: w.to})
+			}
+			return fmt.Errorf("error reading %s blocks %d-%d: %w", name, w.from, w.to, err)
+		}
+		defer it.Close()
+		var page []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet
+		for it.Next() {
+			page = append(page, it.Event)
+		}
+		if err := it.Error(); err != nil {
+			return fmt.Errorf("error reading %s blocks %d-%d: %w", name, w.from, w.to, err)
+		}
+		if s.config.Sink != nil {
+			if err := s.config.Sink.WriteRewardsForAllSubmitterSet(ctx, page); err != nil {
+				return fmt.Errorf("error writing %s to sink: %w", name, err)
+			}
+		}
+		mu.Lock()
+		events = append(events, page...)
+		mu.Unlock()
+		return nil
+	}
+
+	if err := runWindows(ctx, splitWindows(from, toBlock, s.config.PageSize), s.config.Concurrency, fetch); err != nil {
+		return nil, err
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Raw.BlockNumber != events[j].Raw.BlockNumber {
+			return events[i].Raw.BlockNumber < events[j].Raw.BlockNumber
+		}
+		return events[i].Raw.Index < events[j].Raw.Index
+	})
+
+	if s.config.Checkpoints != nil {
+		if err := s.config.Checkpoints.SaveCheckpoint(ctx, name, toBlock); err != nil {
+			return nil, fmt.Errorf("error saving checkpoint for %s: %w", name, err)
+		}
+	}
+	return &RewardsForAllSubmitterSetIterator{events: events}, nil
+}
+
+// RewardsSubmissionForAllCreatedIterator walks a Scan's merged,
+// block-ordered results, shaped like the generated
+// ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator.
+type RewardsSubmissionForAllCreatedIterator struct {
+	events []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated
+	pos    int
+	Event  *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated
+}
+
+// Next advances the iterator, reporting whether another event is
+// available.
+func (it *RewardsSubmissionForAllCreatedIterator) Next() bool {
+	if it.pos >= len(it.events) {
+		return false
+	}
+	it.Event = it.events[it.pos]
+	it.pos++
+	return true
+}
+
+// Error always returns nil; see RewardsForAllSubmitterSetIterator.Error.
+func (it *RewardsSubmissionForAllCreatedIterator) Error() error { return nil }
+
+// Close is a no-op; see RewardsForAllSubmitterSetIterator.Close.
+func (it *RewardsSubmissionForAllCreatedIterator) Close() error { return nil }
+
+// ScanRewardsSubmissionForAllCreated returns every
+// RewardsSubmissionForAllCreated event in [fromBlock, toBlock] (or from
+// Config.Checkpoints' saved checkpoint, if later), fetching
+// Config.PageSize-sized windows Config.Concurrency at a time.
+func (s *Scanner) ScanRewardsSubmissionForAllCreated(ctx context.Context, fromBlock, toBlock uint64) (*RewardsSubmissionForAllCreatedIterator, error) {
+	const name = "RewardsSubmissionForAllCreated"
+	from, err := resumeFrom(ctx, s.config.Checkpoints, name, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > toBlock {
+		return &RewardsSubmissionForAllCreatedIterator{}, nil
+	}
+
+	var mu sync.Mutex
+	var events []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated
+	var fetch func(ctx context.Context, w window) error
+	fetch = func(ctx context.Context, w window) error {
+		it, err := s.filterer.FilterRewardsSubmissionForAllCreated(&bind.FilterOpts{Start: w.from, End: &w.to, Context: ctx}, nil, nil, nil)
+		if err != nil {
+			if rpcerrors.IsRangeTooLarge(err) && w.from < w.to {
+				mid := w.from + (w.to-w.from)/2
+				if s.config.Logger != nil {
+					s.config.Logger.Info("getLogs range rejected as too large, bisecting", "event", name, "from", w.from, "to", w.to)
+				}
+				if err := fetch(ctx, window{from: w.from, to: mid}); err != nil {
+					return err
+				}
+				return fetch(ctx, window{from: mid + 1, to: w.to})
+			}
+			return fmt.Errorf("error reading %s blocks %d-%d: %w", name, w.from, w.to, err)
+		}
+		defer it.Close()
+		var page []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated
+		for it.Next() {
+			page = append(page, it.Event)
+		}
+		if err := it.Error(); err != nil {
+			return fmt.Errorf("error reading %s blocks %d-%d: %w", name, w.from, w.to, err)
+		}
+		if s.config.Sink != nil {
+			if err := s.config.Sink.WriteRewardsSubmissionForAllCreated(ctx, page); err != nil {
+				return fmt.Errorf("error writing %s to sink: %w", name, err)
+			}
+		}
+		mu.Lock()
+		events = append(events, page...)
+		mu.Unlock()
+		return nil
+	}
+
+	if err := runWindows(ctx, splitWindows(from, toBlock, s.config.PageSize), s.config.Concurrency, fetch); err != nil {
+		return nil, err
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Raw.BlockNumber != events[j].Raw.BlockNumber {
+			return events[i].Raw.BlockNumber < events[j].Raw.BlockNumber
+		}
+		return events[i].Raw.Index < events[j].Raw.Index
+	})
+
+	if s.config.Checkpoints != nil {
+		if err := s.config.Checkpoints.SaveCheckpoint(ctx, name, toBlock); err != nil {
+			return nil, fmt.Errorf("error saving checkpoint for %s: %w", name, err)
+		}
+	}
+	return &RewardsSubmissionForAllCreatedIterator{events: events}, nil
+}
+
+// RewardsSubmissionForAllEarnersCreatedIterator walks a Scan's merged,
+// block-ordered results, shaped like the generated
+// ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator.
+type RewardsSubmissionForAllEarnersCreatedIterator struct {
+	events []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated
+	pos    int
+	Event  *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated
+}
+
+// Next advances the iterator, reporting whether another event is
+// available.
+func (it *RewardsSubmissionForAllEarnersCreatedIterator) Next() bool {
+	if it.pos >= len(it.events) {
+		return false
+	}
+	it.Event = it.events[it.pos]
+	it.pos++
+	return true
+}
+
+// Error always returns nil; see RewardsForAllSubmitterSetIterator.Error.
+func (it *RewardsSubmissionForAllEarnersCreatedIterator) Error() error { return nil }
+
+// Close is a no-op; see RewardsForAllSubmitterSetIterator.Close.
+func (it *RewardsSubmissionForAllEarnersCreatedIterator) Close() error { return nil }
+
+// ScanRewardsSubmissionForAllEarnersCreated returns every
+// RewardsSubmissionForAllEarnersCreated event in [fromBlock, toBlock] (or
+// from Config.Checkpoints' saved checkpoint, if later), fetching
+// Config.PageSize-sized windows Config.Concurrency at a time.
+func (s *Scanner) ScanRewardsSubmissionForAllEarnersCreated(ctx context.Context, fromBlock, toBlock uint64) (*RewardsSubmissionForAllEarnersCreatedIterator, error) {
+	const name = "RewardsSubmissionForAllEarnersCreated"
+	from, err := resumeFrom(ctx, s.config.Checkpoints, name, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > toBlock {
+		return &RewardsSubmissionForAllEarnersCreatedIterator{}, nil
+	}
+
+	var mu sync.Mutex
+	var events []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated
+	var fetch func(ctx context.Context, w window) error
+	fetch = func(ctx context.Context, w window) error {
+		it, err := s.filterer.FilterRewardsSubmissionForAllEarnersCreated(&bind.FilterOpts{Start: w.from, End: &w.to, Context: ctx}, nil, nil, nil)
+		if err != nil {
+			if rpcerrors.IsRangeTooLarge(err) && w.from < w.to {
+				mid := w.from + (w.to-w.from)/2
+				if s.config.Logger != nil {
+					s.config.Logger.Info("getLogs range rejected as too large, bisecting", "event", name, "from", w.from, "to", w.to)
+				}
+				if err := fetch(ctx, window{from: w.from, to: mid}); err != nil {
+					return err
+				}
+				return fetch(ctx, window{from: mid + 1, to: w.to})
+			}
+			return fmt.Errorf("error reading %s blocks %d-%d: %w", name, w.from, w.to, err)
+		}
+		defer it.Close()
+		var page []*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated
+		for it.Next() {
+			page = append(page, it.Event)
+		}
+		if err := it.Error(); err != nil {
+			return fmt.Errorf("error reading %s blocks %d-%d: %w", name, w.from, w.to, err)
+		}
+		if s.config.Sink != nil {
+			if err := s.config.Sink.WriteRewardsSubmissionForAllEarnersCreated(ctx, page); err != nil {
+				return fmt.Errorf("error writing %s to sink: %w", name, err)
+			}
+		}
+		mu.Lock()
+		events = append(events, page...)
+		mu.Unlock()
+		return nil
+	}
+
+	if err := runWindows(ctx, splitWindows(from, toBlock, s.config.PageSize), s.config.Concurrency, fetch); err != nil {
+		return nil, err
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Raw.BlockNumber != events[j].Raw.BlockNumber {
+			return events[i].Raw.BlockNumber < events[j].Raw.BlockNumber
+		}
+		return events[i].Raw.Index < events[j].Raw.Index
+	})
+
+	if s.config.Checkpoints != nil {
+		if err := s.config.Checkpoints.SaveCheckpoint(ctx, name, toBlock); err != nil {
+			return nil, fmt.Errorf("error saving checkpoint for %s: %w", name, err)
+		}
+	}
+	return &RewardsSubmissionForAllEarnersCreatedIterator{events: events}, nil
+}
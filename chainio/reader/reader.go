@@ -0,0 +1,231 @@
+// Package reader provides RewardsReader, a typed high-level read API over
+// the RewardsCoordinator's generated Filter* iterators. Most RPC providers
+// cap a single eth_getLogs call at some number of blocks or results, so
+// each GetXInRange method transparently splits [fromBlock, toBlock] into
+// chunkSize windows and halves the window (down to ReaderConfig's
+// MinChunkSize) whenever a provider rejects a range as too large, instead
+// of requiring callers to reimplement that pagination themselves. Pass a
+// callback to stream results as they're read rather than buffering the
+// whole range in memory.
+package reader
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// DistributionRoot, DistributionRootDisabled, DefaultOperatorSplitBipsSet,
+// OperatorAVSSplitBipsSet and OperatorPISplitBipsSet are convenience
+// aliases for the generated event structs this reader pages through.
+type (
+	DistributionRoot            = contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted
+	DistributionRootDisabled    = contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled
+	DefaultOperatorSplitBipsSet = contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet
+	OperatorAVSSplitBipsSet     = contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet
+	OperatorPISplitBipsSet      = contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet
+)
+
+// eventFilterer is the subset of the RewardsCoordinator binding this
+// reader paginates over.
+type eventFilterer interface {
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+}
+
+// ReaderConfig bounds how far RewardsReader will shrink a chunk before
+// giving up on a range as unreadable.
+type ReaderConfig struct {
+	// MinChunkSize is the smallest window a GetXInRange method will
+	// retry with before returning an error. Defaults to 100.
+	MinChunkSize uint64
+}
+
+// RewardsReader pages through RewardsCoordinator event logs, transparently
+// splitting wide ranges to fit whatever limit the backing RPC provider
+// enforces.
+type RewardsReader struct {
+	filterer eventFilterer
+	config   ReaderConfig
+	logger   logging.Logger
+}
+
+// NewRewardsReader returns a RewardsReader reading from filterer.
+func NewRewardsReader(filterer eventFilterer, config ReaderConfig, logger logging.Logger) *RewardsReader {
+	if config.MinChunkSize == 0 {
+		config.MinChunkSize = 100
+	}
+	return &RewardsReader{filterer: filterer, config: config, logger: logger}
+}
+
+// GetDistributionRootsInRange returns every DistributionRootSubmitted
+// event in [fromBlock, toBlock], requested chunkSize blocks at a time. If
+// onEvent is non-nil, it's called with each event as it's read and the
+// returned slice is nil, so the whole range never has to fit in memory at
+// once; otherwise every event is accumulated and returned.
+func (r *RewardsReader) GetDistributionRootsInRange(ctx context.Context, fromBlock, toBlock, chunkSize uint64, onEvent func(DistributionRoot) error) ([]DistributionRoot, error) {
+	var results []DistributionRoot
+	err := r.paginate(ctx, fromBlock, toBlock, chunkSize, func(opts *bind.FilterOpts) error {
+		it, err := r.filterer.FilterDistributionRootSubmitted(opts, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			if onEvent != nil {
+				if err := onEvent(*it.Event); err != nil {
+					return err
+				}
+			} else {
+				results = append(results, *it.Event)
+			}
+		}
+		return it.Error()
+	})
+	return results, err
+}
+
+// GetDistributionRootsDisabledInRange is GetDistributionRootsInRange for
+// DistributionRootDisabled events.
+func (r *RewardsReader) GetDistributionRootsDisabledInRange(ctx context.Context, fromBlock, toBlock, chunkSize uint64, onEvent func(DistributionRootDisabled) error) ([]DistributionRootDisabled, error) {
+	var results []DistributionRootDisabled
+	err := r.paginate(ctx, fromBlock, toBlock, chunkSize, func(opts *bind.FilterOpts) error {
+		it, err := r.filterer.FilterDistributionRootDisabled(opts, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			if onEvent != nil {
+				if err := onEvent(*it.Event); err != nil {
+					return err
+				}
+			} else {
+				results = append(results, *it.Event)
+			}
+		}
+		return it.Error()
+	})
+	return results, err
+}
+
+// GetDefaultOperatorSplitBipsSetInRange is GetDistributionRootsInRange for
+// DefaultOperatorSplitBipsSet events.
+func (r *RewardsReader) GetDefaultOperatorSplitBipsSetInRange(ctx context.Context, fromBlock, toBlock, chunkSize uint64, onEvent func(DefaultOperatorSplitBipsSet) error) ([]DefaultOperatorSplitBipsSet, error) {
+	var results []DefaultOperatorSplitBipsSet
+	err := r.paginate(ctx, fromBlock, toBlock, chunkSize, func(opts *bind.FilterOpts) error {
+		it, err := r.filterer.FilterDefaultOperatorSplitBipsSet(opts)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			if onEvent != nil {
+				if err := onEvent(*it.Event); err != nil {
+					return err
+				}
+			} else {
+				results = append(results, *it.Event)
+			}
+		}
+		return it.Error()
+	})
+	return results, err
+}
+
+// GetOperatorAVSSplitBipsSetInRange is GetDistributionRootsInRange for
+// OperatorAVSSplitBipsSet events.
+func (r *RewardsReader) GetOperatorAVSSplitBipsSetInRange(ctx context.Context, fromBlock, toBlock, chunkSize uint64, onEvent func(OperatorAVSSplitBipsSet) error) ([]OperatorAVSSplitBipsSet, error) {
+	var results []OperatorAVSSplitBipsSet
+	err := r.paginate(ctx, fromBlock, toBlock, chunkSize, func(opts *bind.FilterOpts) error {
+		it, err := r.filterer.FilterOperatorAVSSplitBipsSet(opts, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			if onEvent != nil {
+				if err := onEvent(*it.Event); err != nil {
+					return err
+				}
+			} else {
+				results = append(results, *it.Event)
+			}
+		}
+		return it.Error()
+	})
+	return results, err
+}
+
+// GetOperatorPISplitBipsSetInRange is GetDistributionRootsInRange for
+// OperatorPISplitBipsSet events.
+func (r *RewardsReader) GetOperatorPISplitBipsSetInRange(ctx context.Context, fromBlock, toBlock, chunkSize uint64, onEvent func(OperatorPISplitBipsSet) error) ([]OperatorPISplitBipsSet, error) {
+	var results []OperatorPISplitBipsSet
+	err := r.paginate(ctx, fromBlock, toBlock, chunkSize, func(opts *bind.FilterOpts) error {
+		it, err := r.filterer.FilterOperatorPISplitBipsSet(opts, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			if onEvent != nil {
+				if err := onEvent(*it.Event); err != nil {
+					return err
+				}
+			} else {
+				results = append(results, *it.Event)
+			}
+		}
+		return it.Error()
+	})
+	return results, err
+}
+
+// paginate walks [from, to] in chunkSize windows, calling fetch for each
+// one. If fetch fails with an error that looks like the provider rejecting
+// the range as too large, paginate halves the window (down to
+// ReaderConfig.MinChunkSize) and retries the same starting block rather
+// than failing the whole range.
+func (r *RewardsReader) paginate(ctx context.Context, from, to, chunkSize uint64, fetch func(opts *bind.FilterOpts) error) error {
+	if chunkSize == 0 {
+		chunkSize = to - from + 1
+	}
+	window := chunkSize
+
+	for from <= to {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := from + window - 1
+		if end > to {
+			end = to
+		}
+
+		err := fetch(&bind.FilterOpts{Start: from, End: &end, Context: ctx})
+		if err != nil {
+			if rpcerrors.IsRangeTooLarge(err) {
+				if window <= r.config.MinChunkSize {
+					return fmt.Errorf("error reading blocks %d-%d: range still rejected at minimum chunk size %d: %w", from, end, r.config.MinChunkSize, err)
+				}
+				window /= 2
+				if window < r.config.MinChunkSize {
+					window = r.config.MinChunkSize
+				}
+				r.logger.Info("getLogs range rejected as too large, halving chunk size", "newChunkSize", window)
+				continue
+			}
+			return fmt.Errorf("error reading blocks %d-%d: %w", from, end, err)
+		}
+		from = end + 1
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Package events wraps five of the RewardsCoordinator binding's
+// Filter*/Watch* event pairs - OperatorAVSSplitBipsSet,
+// OperatorDirectedAVSRewardsSubmissionCreated, OperatorPISplitBipsSet,
+// RewardsClaimed, and RewardsForAllSubmitterSet - behind a single
+// Indexer, so callers don't have to hand-write the Iterator/Watch
+// boilerplate generated for each one. Indexer narrows a
+// chainio/subscriber/rewardscoordinator.Subscriber's fourteen-event
+// stream down to these five, which is also where backfill chunking,
+// confirmations, and reorg-safe re-delivery now live - Events are
+// re-emitted with Invalidated set when the Subscriber reports them
+// Reverted.
+package events
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// EventKind identifies which of the five tracked events an Event carries.
+type EventKind int
+
+const (
+	KindOperatorAVSSplitBipsSet EventKind = iota
+	KindOperatorDirectedAVSRewardsSubmissionCreated
+	KindOperatorPISplitBipsSet
+	KindRewardsClaimed
+	KindRewardsForAllSubmitterSet
+)
+
+// String returns the event name as used for CursorStore lookups.
+func (k EventKind) String() string {
+	switch k {
+	case KindOperatorAVSSplitBipsSet:
+		return "OperatorAVSSplitBipsSet"
+	case KindOperatorDirectedAVSRewardsSubmissionCreated:
+		return "OperatorDirectedAVSRewardsSubmissionCreated"
+	case KindOperatorPISplitBipsSet:
+		return "OperatorPISplitBipsSet"
+	case KindRewardsClaimed:
+		return "RewardsClaimed"
+	case KindRewardsForAllSubmitterSet:
+		return "RewardsForAllSubmitterSet"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is the tagged-union element Indexer delivers. Exactly one of the
+// typed fields matching Kind is non-nil. Invalidated is set when this
+// event's block has since been reorged out of the canonical chain.
+type Event struct {
+	Kind        EventKind
+	BlockNumber uint64
+	BlockHash   common.Hash
+	LogIndex    uint
+	Invalidated bool
+
+	OperatorAVSSplitBipsSet                     *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet
+	OperatorDirectedAVSRewardsSubmissionCreated *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated
+	OperatorPISplitBipsSet                      *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet
+	RewardsClaimed                              *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed
+	RewardsForAllSubmitterSet                   *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet
+}
+
+// Handlers holds optional per-event callbacks. Run delivers to whichever
+// of these are non-nil in addition to (not instead of) the channel
+// returned by Run.
+type Handlers struct {
+	OnOperatorAVSSplitBipsSet                     func(Event)
+	OnOperatorDirectedAVSRewardsSubmissionCreated func(Event)
+	OnOperatorPISplitBipsSet                      func(Event)
+	OnRewardsClaimed                              func(Event)
+	OnRewardsForAllSubmitterSet                   func(Event)
+}
+
+func (h Handlers) dispatch(ev Event) {
+	var fn func(Event)
+	switch ev.Kind {
+	case KindOperatorAVSSplitBipsSet:
+		fn = h.OnOperatorAVSSplitBipsSet
+	case KindOperatorDirectedAVSRewardsSubmissionCreated:
+		fn = h.OnOperatorDirectedAVSRewardsSubmissionCreated
+	case KindOperatorPISplitBipsSet:
+		fn = h.OnOperatorPISplitBipsSet
+	case KindRewardsClaimed:
+		fn = h.OnRewardsClaimed
+	case KindRewardsForAllSubmitterSet:
+		fn = h.OnRewardsForAllSubmitterSet
+	}
+	if fn != nil {
+		fn(ev)
+	}
+}
+
+// eventFilterer is the full RewardsCoordinator binding surface, identical
+// to rewardscoordinator's own (unexported) eventFilterer - redeclared
+// here so Indexer's constructor can name it without depending on that
+// package's internals. Multiplexer (in this same package) only calls the
+// five events' worth of methods it actually tracks, but Indexer's
+// underlying Subscriber needs all fourteen to multiplex its stream.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
@@ -0,0 +1,193 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// watchMinBackoff and watchMaxBackoff bound Multiplexer's own resubscribe
+// backoff; Indexer no longer needs them itself, since its underlying
+// Subscriber already retries a dropped watch indefinitely with its own
+// backoff.
+const (
+	watchMinBackoff = time.Second
+	watchMaxBackoff = time.Minute
+)
+
+// Config controls an Indexer's underlying Subscriber.
+type Config struct {
+	// StartBlock is used in place of a saved cursor on the first backfill.
+	StartBlock uint64
+	Subscriber rewardscoordinator.Config
+}
+
+// Indexer narrows a rewardscoordinator.Subscriber's fourteen-event stream
+// down to the five events this package tracks, resuming from a
+// CursorStore instead of requiring callers to pick a fromBlock
+// themselves.
+//
+// The five events are delivered through one shared Subscriber, so they
+// share a single point of resumption: after each delivered event, Indexer
+// saves its block to every tracked event's cursor entry rather than
+// advancing them independently. A prior version of this package polled
+// each event separately and fell back from Watch* to re-polling when the
+// RPC transport didn't support eth_subscribe; that fallback is dropped
+// here; Subscriber already retries a dropped or refused subscription
+// indefinitely with backoff; a transport that never supports
+// eth_subscribe at all needs a polling-capable filterer passed into
+// rewardscoordinator.New, not a second implementation of polling in every
+// downstream package.
+type Indexer struct {
+	sub      *rewardscoordinator.Subscriber
+	cursors  CursorStore
+	handlers Handlers
+	config   Config
+	logger   logging.Logger
+}
+
+// trackedKinds are the five events Indexer narrows the Subscriber's
+// stream down to.
+var trackedKinds = []EventKind{
+	KindOperatorAVSSplitBipsSet,
+	KindOperatorDirectedAVSRewardsSubmissionCreated,
+	KindOperatorPISplitBipsSet,
+	KindRewardsClaimed,
+	KindRewardsForAllSubmitterSet,
+}
+
+// New returns an Indexer reading from filterer. headFunc resolves the
+// current chain head, passed straight through to a new
+// rewardscoordinator.Subscriber.
+func New(
+	filterer eventFilterer,
+	cursors CursorStore,
+	headFunc func(ctx context.Context) (uint64, error),
+	handlers Handlers,
+	config Config,
+	logger logging.Logger,
+) *Indexer {
+	return &Indexer{
+		sub:      rewardscoordinator.New(filterer, headFunc, config.Subscriber, logger),
+		cursors:  cursors,
+		handlers: handlers,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run resumes from the furthest-along saved cursor among the five tracked
+// events (or Config.StartBlock, if none is saved yet), then delivers
+// every tracked event - backfilled or live - to the returned channel as
+// well as to any configured Handlers, until ctx is canceled.
+func (idx *Indexer) Run(ctx context.Context) (<-chan Event, error) {
+	from, err := idx.resumeFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make(chan rewardscoordinator.RewardsCoordinatorEvent)
+	sub, err := idx.sub.Subscribe(ctx, from, raw)
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to RewardsCoordinator events: %w", err)
+	}
+
+	out := make(chan Event, 256)
+	go func() {
+		defer close(out)
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-sub.Err():
+				if ok && err != nil {
+					idx.logger.Error("error in RewardsCoordinator subscription", "err", err)
+				}
+				return
+			case ev, ok := <-raw:
+				if !ok {
+					return
+				}
+				mapped, ok := mapEvent(ev)
+				if !ok {
+					continue
+				}
+				idx.handlers.dispatch(mapped)
+				if !mapped.Invalidated {
+					if err := idx.saveCursors(ctx, mapped.BlockNumber); err != nil {
+						idx.logger.Error("error saving cursor", "block", mapped.BlockNumber, "err", err)
+					}
+				}
+				select {
+				case out <- mapped:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// resumeFrom returns one block past the furthest-along cursor saved for
+// any tracked event, or Config.StartBlock if none has been saved yet.
+func (idx *Indexer) resumeFrom(ctx context.Context) (uint64, error) {
+	from := idx.config.StartBlock
+	found := false
+	for _, kind := range trackedKinds {
+		cursor, ok, err := idx.cursors.LoadCursor(ctx, kind.String())
+		if err != nil {
+			return 0, fmt.Errorf("error loading cursor for %s: %w", kind, err)
+		}
+		if ok && (!found || cursor > from) {
+			from = cursor
+			found = true
+		}
+	}
+	if found {
+		from++
+	}
+	return from, nil
+}
+
+// saveCursors records block against every tracked event's cursor entry,
+// since all five advance together through one shared Subscriber.
+func (idx *Indexer) saveCursors(ctx context.Context, block uint64) error {
+	for _, kind := range trackedKinds {
+		if err := idx.cursors.SaveCursor(ctx, kind.String(), block); err != nil {
+			return fmt.Errorf("error saving cursor for %s: %w", kind, err)
+		}
+	}
+	return nil
+}
+
+// mapEvent translates a RewardsCoordinatorEvent into an Event, if its
+// Kind is one of the five this package tracks.
+func mapEvent(ev rewardscoordinator.RewardsCoordinatorEvent) (Event, bool) {
+	out := Event{BlockNumber: ev.BlockNumber, BlockHash: ev.BlockHash, LogIndex: ev.LogIndex, Invalidated: ev.Reverted}
+	switch ev.Kind {
+	case rewardscoordinator.KindOperatorAVSSplitBipsSet:
+		out.Kind = KindOperatorAVSSplitBipsSet
+		out.OperatorAVSSplitBipsSet = ev.OperatorAVSSplitBipsSet
+	case rewardscoordinator.KindOperatorDirectedAVSRewardsSubmissionCreated:
+		out.Kind = KindOperatorDirectedAVSRewardsSubmissionCreated
+		out.OperatorDirectedAVSRewardsSubmissionCreated = ev.OperatorDirectedAVSRewardsSubmissionCreated
+	case rewardscoordinator.KindOperatorPISplitBipsSet:
+		out.Kind = KindOperatorPISplitBipsSet
+		out.OperatorPISplitBipsSet = ev.OperatorPISplitBipsSet
+	case rewardscoordinator.KindRewardsClaimed:
+		out.Kind = KindRewardsClaimed
+		out.RewardsClaimed = ev.RewardsClaimed
+	case rewardscoordinator.KindRewardsForAllSubmitterSet:
+		out.Kind = KindRewardsForAllSubmitterSet
+		out.RewardsForAllSubmitterSet = ev.RewardsForAllSubmitterSet
+	default:
+		return Event{}, false
+	}
+	return out, true
+}
@@ -0,0 +1,600 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// catchUpChunkSize and catchUpMinChunkSize bound how runMuxSource paginates
+// a post-reconnect catch-up: unlike rewardscoordinator.Subscriber's own
+// chunked, reorg-aware backfill, Multiplexer's sources each carry their own
+// indexed-topic filter arguments, which Subscriber's unfiltered Watch*/
+// Filter* calls don't support - so this package can't simply delegate to
+// Subscriber and has to halve its own range on a too-large rejection.
+const (
+	catchUpChunkSize    = 10_000
+	catchUpMinChunkSize = 100
+)
+
+// RewardsEvent is implemented by every concrete event type a Multiplexer
+// can deliver, so callers can type-switch on the concrete struct rather
+// than branching on an enum field.
+type RewardsEvent interface {
+	isRewardsEvent()
+	// BlockNumber is the block the event was (or, for a reorg-replayed
+	// catch-up event, originally was) logged in.
+	BlockNumber() uint64
+}
+
+// OperatorAVSSplitBipsSetEvent is the OperatorAVSSplitBipsSet RewardsEvent.
+type OperatorAVSSplitBipsSetEvent struct {
+	Raw *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet
+}
+
+func (OperatorAVSSplitBipsSetEvent) isRewardsEvent()       {}
+func (e OperatorAVSSplitBipsSetEvent) BlockNumber() uint64 { return e.Raw.Raw.BlockNumber }
+
+// OperatorDirectedAVSRewardsSubmissionCreatedEvent is the
+// OperatorDirectedAVSRewardsSubmissionCreated RewardsEvent.
+type OperatorDirectedAVSRewardsSubmissionCreatedEvent struct {
+	Raw *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated
+}
+
+func (OperatorDirectedAVSRewardsSubmissionCreatedEvent) isRewardsEvent() {}
+func (e OperatorDirectedAVSRewardsSubmissionCreatedEvent) BlockNumber() uint64 {
+	return e.Raw.Raw.BlockNumber
+}
+
+// OperatorPISplitBipsSetEvent is the OperatorPISplitBipsSet RewardsEvent.
+type OperatorPISplitBipsSetEvent struct {
+	Raw *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet
+}
+
+func (OperatorPISplitBipsSetEvent) isRewardsEvent()       {}
+func (e OperatorPISplitBipsSetEvent) BlockNumber() uint64 { return e.Raw.Raw.BlockNumber }
+
+// RewardsClaimedEvent is the RewardsClaimed RewardsEvent.
+type RewardsClaimedEvent struct {
+	Raw *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed
+}
+
+func (RewardsClaimedEvent) isRewardsEvent()       {}
+func (e RewardsClaimedEvent) BlockNumber() uint64 { return e.Raw.Raw.BlockNumber }
+
+// RewardsForAllSubmitterSetEvent is the RewardsForAllSubmitterSet
+// RewardsEvent.
+type RewardsForAllSubmitterSetEvent struct {
+	Raw *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet
+}
+
+func (RewardsForAllSubmitterSetEvent) isRewardsEvent()       {}
+func (e RewardsForAllSubmitterSetEvent) BlockNumber() uint64 { return e.Raw.Raw.BlockNumber }
+
+// OverflowPolicy controls what a Multiplexer does when its internal ring
+// buffer is full and a producer has another event to deliver.
+type OverflowPolicy int
+
+const (
+	// BlockProducer leaves events in arrival order by making the
+	// producer (and therefore the underlying Watch* subscription) wait
+	// for the consumer to make room. This never drops an event, but a
+	// slow consumer will eventually stall every subscription.
+	BlockProducer OverflowPolicy = iota
+	// DropOldest discards the oldest buffered event to make room for the
+	// newest, trading completeness for a consumer that never blocks
+	// subscriptions open.
+	DropOldest
+)
+
+// muxSource is one event type a Multiplexer watches: watch opens a live
+// subscription and returns a channel of decoded RewardsEvents alongside
+// it; catchUp replays [from, to] via FilterLogs, used to fill the gap
+// left by a dropped subscription before it's re-attached.
+type muxSource struct {
+	watch   func(ctx context.Context, filterer eventFilterer) (<-chan RewardsEvent, event.Subscription, error)
+	catchUp func(ctx context.Context, filterer eventFilterer, from, to uint64) ([]RewardsEvent, error)
+}
+
+// MultiplexerOption selects an event type (and its indexed-topic filter
+// arguments) for a Multiplexer to watch, or tunes its buffering.
+type MultiplexerOption func(*multiplexerConfig)
+
+type multiplexerConfig struct {
+	sources    []muxSource
+	bufferSize int
+	overflow   OverflowPolicy
+}
+
+// WithBufferSize overrides the Multiplexer's default ring buffer capacity
+// of 256 events.
+func WithBufferSize(n int) MultiplexerOption {
+	return func(c *multiplexerConfig) { c.bufferSize = n }
+}
+
+// WithOverflowPolicy overrides the Multiplexer's default OverflowPolicy of
+// BlockProducer.
+func WithOverflowPolicy(p OverflowPolicy) MultiplexerOption {
+	return func(c *multiplexerConfig) { c.overflow = p }
+}
+
+// WithOperatorAVSSplitBipsSet subscribes the Multiplexer to
+// OperatorAVSSplitBipsSet, filtered by caller/operator/avs.
+func WithOperatorAVSSplitBipsSet(caller, operator, avs []common.Address) MultiplexerOption {
+	return func(c *multiplexerConfig) {
+		c.sources = append(c.sources, muxSource{
+			watch: func(ctx context.Context, filterer eventFilterer) (<-chan RewardsEvent, event.Subscription, error) {
+				sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet)
+				sub, err := filterer.WatchOperatorAVSSplitBipsSet(&bind.WatchOpts{Context: ctx}, sink, caller, operator, avs)
+				if err != nil {
+					return nil, nil, err
+				}
+				out := make(chan RewardsEvent)
+				go func() {
+					defer close(out)
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case ev, ok := <-sink:
+							if !ok {
+								return
+							}
+							select {
+							case out <- OperatorAVSSplitBipsSetEvent{Raw: ev}:
+							case <-ctx.Done():
+								return
+							}
+						}
+					}
+				}()
+				return out, sub, nil
+			},
+			catchUp: func(ctx context.Context, filterer eventFilterer, from, to uint64) ([]RewardsEvent, error) {
+				it, err := filterer.FilterOperatorAVSSplitBipsSet(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, caller, operator, avs)
+				if err != nil {
+					return nil, err
+				}
+				defer it.Close()
+				var out []RewardsEvent
+				for it.Next() {
+					out = append(out, OperatorAVSSplitBipsSetEvent{Raw: it.Event})
+				}
+				return out, it.Error()
+			},
+		})
+	}
+}
+
+// WithOperatorDirectedAVSRewardsSubmissionCreated subscribes the
+// Multiplexer to OperatorDirectedAVSRewardsSubmissionCreated, filtered by
+// caller/avs/operatorDirectedRewardsSubmissionHash.
+func WithOperatorDirectedAVSRewardsSubmissionCreated(caller, avs []common.Address, hash [][32]byte) MultiplexerOption {
+	return func(c *multiplexerConfig) {
+		c.sources = append(c.sources, muxSource{
+			watch: func(ctx context.Context, filterer eventFilterer) (<-chan RewardsEvent, event.Subscription, error) {
+				sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated)
+				sub, err := filterer.WatchOperatorDirectedAVSRewardsSubmissionCreated(&bind.WatchOpts{Context: ctx}, sink, caller, avs, hash)
+				if err != nil {
+					return nil, nil, err
+				}
+				out := make(chan RewardsEvent)
+				go func() {
+					defer close(out)
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case ev, ok := <-sink:
+							if !ok {
+								return
+							}
+							select {
+							case out <- OperatorDirectedAVSRewardsSubmissionCreatedEvent{Raw: ev}:
+							case <-ctx.Done():
+								return
+							}
+						}
+					}
+				}()
+				return out, sub, nil
+			},
+			catchUp: func(ctx context.Context, filterer eventFilterer, from, to uint64) ([]RewardsEvent, error) {
+				it, err := filterer.FilterOperatorDirectedAVSRewardsSubmissionCreated(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, caller, avs, hash)
+				if err != nil {
+					return nil, err
+				}
+				defer it.Close()
+				var out []RewardsEvent
+				for it.Next() {
+					out = append(out, OperatorDirectedAVSRewardsSubmissionCreatedEvent{Raw: it.Event})
+				}
+				return out, it.Error()
+			},
+		})
+	}
+}
+
+// WithOperatorPISplitBipsSet subscribes the Multiplexer to
+// OperatorPISplitBipsSet, filtered by caller/operator.
+func WithOperatorPISplitBipsSet(caller, operator []common.Address) MultiplexerOption {
+	return func(c *multiplexerConfig) {
+		c.sources = append(c.sources, muxSource{
+			watch: func(ctx context.Context, filterer eventFilterer) (<-chan RewardsEvent, event.Subscription, error) {
+				sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet)
+				sub, err := filterer.WatchOperatorPISplitBipsSet(&bind.WatchOpts{Context: ctx}, sink, caller, operator)
+				if err != nil {
+					return nil, nil, err
+				}
+				out := make(chan RewardsEvent)
+				go func() {
+					defer close(out)
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case ev, ok := <-sink:
+							if !ok {
+								return
+							}
+							select {
+							case out <- OperatorPISplitBipsSetEvent{Raw: ev}:
+							case <-ctx.Done():
+								return
+							}
+						}
+					}
+				}()
+				return out, sub, nil
+			},
+			catchUp: func(ctx context.Context, filterer eventFilterer, from, to uint64) ([]RewardsEvent, error) {
+				it, err := filterer.FilterOperatorPISplitBipsSet(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, caller, operator)
+				if err != nil {
+					return nil, err
+				}
+				defer it.Close()
+				var out []RewardsEvent
+				for it.Next() {
+					out = append(out, OperatorPISplitBipsSetEvent{Raw: it.Event})
+				}
+				return out, it.Error()
+			},
+		})
+	}
+}
+
+// WithRewardsClaimed subscribes the Multiplexer to RewardsClaimed,
+// filtered by earner/claimer/recipient.
+func WithRewardsClaimed(earner, claimer, recipient []common.Address) MultiplexerOption {
+	return func(c *multiplexerConfig) {
+		c.sources = append(c.sources, muxSource{
+			watch: func(ctx context.Context, filterer eventFilterer) (<-chan RewardsEvent, event.Subscription, error) {
+				sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed)
+				sub, err := filterer.WatchRewardsClaimed(&bind.WatchOpts{Context: ctx}, sink, earner, claimer, recipient)
+				if err != nil {
+					return nil, nil, err
+				}
+				out := make(chan RewardsEvent)
+				go func() {
+					defer close(out)
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case ev, ok := <-sink:
+							if !ok {
+								return
+							}
+							select {
+							case out <- RewardsClaimedEvent{Raw: ev}:
+							case <-ctx.Done():
+								return
+							}
+						}
+					}
+				}()
+				return out, sub, nil
+			},
+			catchUp: func(ctx context.Context, filterer eventFilterer, from, to uint64) ([]RewardsEvent, error) {
+				it, err := filterer.FilterRewardsClaimed(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, earner, claimer, recipient)
+				if err != nil {
+					return nil, err
+				}
+				defer it.Close()
+				var out []RewardsEvent
+				for it.Next() {
+					out = append(out, RewardsClaimedEvent{Raw: it.Event})
+				}
+				return out, it.Error()
+			},
+		})
+	}
+}
+
+// WithRewardsForAllSubmitterSet subscribes the Multiplexer to
+// RewardsForAllSubmitterSet, filtered by rewardsForAllSubmitter/oldValue/newValue.
+func WithRewardsForAllSubmitterSet(rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) MultiplexerOption {
+	return func(c *multiplexerConfig) {
+		c.sources = append(c.sources, muxSource{
+			watch: func(ctx context.Context, filterer eventFilterer) (<-chan RewardsEvent, event.Subscription, error) {
+				sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet)
+				sub, err := filterer.WatchRewardsForAllSubmitterSet(&bind.WatchOpts{Context: ctx}, sink, rewardsForAllSubmitter, oldValue, newValue)
+				if err != nil {
+					return nil, nil, err
+				}
+				out := make(chan RewardsEvent)
+				go func() {
+					defer close(out)
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case ev, ok := <-sink:
+							if !ok {
+								return
+							}
+							select {
+							case out <- RewardsForAllSubmitterSetEvent{Raw: ev}:
+							case <-ctx.Done():
+								return
+							}
+						}
+					}
+				}()
+				return out, sub, nil
+			},
+			catchUp: func(ctx context.Context, filterer eventFilterer, from, to uint64) ([]RewardsEvent, error) {
+				it, err := filterer.FilterRewardsForAllSubmitterSet(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, rewardsForAllSubmitter, oldValue, newValue)
+				if err != nil {
+					return nil, err
+				}
+				defer it.Close()
+				var out []RewardsEvent
+				for it.Next() {
+					out = append(out, RewardsForAllSubmitterSetEvent{Raw: it.Event})
+				}
+				return out, it.Error()
+			},
+		})
+	}
+}
+
+// Multiplexer merges several RewardsCoordinator Watch* subscriptions -
+// selected via MultiplexerOption, each with its own indexed-topic filter
+// arguments - into a single <-chan RewardsEvent, replacing the hand-rolled
+// select loop a caller would otherwise write per event type. Each source
+// reconnects independently on a transient drop, catching up via FilterLogs
+// from its last delivered block before re-attaching so no log is lost to
+// the gap.
+//
+// This intentionally doesn't wrap a chainio/subscriber/rewardscoordinator.
+// Subscriber the way most other packages under chainio now do: Subscriber's
+// Watch*/Filter* calls are always unfiltered, so it has nowhere to plug in
+// the per-option caller/operator/avs-style filters a Multiplexer caller
+// selects. What this package still shares with Subscriber is the shape of
+// the fix, not the mechanism: reconnect backoff and chunked, halving
+// catch-up on a too-large range (via chainio/rpcerrors), applied to its own
+// filtered per-source subscriptions instead of to Subscriber's unfiltered
+// ones.
+type Multiplexer struct {
+	filterer eventFilterer
+	headFunc func(ctx context.Context) (uint64, error)
+	config   multiplexerConfig
+}
+
+// NewMultiplexer returns a Multiplexer reading from filterer. headFunc
+// resolves the current chain head, used to bound each source's
+// post-reconnect catch-up.
+func NewMultiplexer(filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), opts ...MultiplexerOption) *Multiplexer {
+	config := multiplexerConfig{bufferSize: 256, overflow: BlockProducer}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return &Multiplexer{filterer: filterer, headFunc: headFunc, config: config}
+}
+
+// Run starts one supervised goroutine per selected source and returns the
+// merged channel. The channel is closed once ctx is canceled and every
+// source has exited.
+func (m *Multiplexer) Run(ctx context.Context) <-chan RewardsEvent {
+	intake := make(chan RewardsEvent)
+	out := make(chan RewardsEvent)
+
+	done := make(chan struct{}, len(m.config.sources))
+	for _, src := range m.config.sources {
+		src := src
+		go func() {
+			defer func() { done <- struct{}{} }()
+			runMuxSource(ctx, m.filterer, m.headFunc, src, intake)
+		}()
+	}
+	go func() {
+		for range m.config.sources {
+			<-done
+		}
+		close(intake)
+	}()
+	go func() {
+		defer close(out)
+		muxLoop(ctx, intake, out, m.config.bufferSize, m.config.overflow)
+	}()
+
+	return out
+}
+
+// runMuxSource watches src, forwarding every event to intake, and
+// reconnects with exponential backoff (bounded by watchMaxBackoff)
+// whenever the subscription ends - first replaying [lastSeen+1, head] via
+// src.catchUp so the reconnect gap isn't silently dropped.
+func runMuxSource(ctx context.Context, filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), src muxSource, intake chan<- RewardsEvent) {
+	var lastSeen uint64
+	backoff := watchMinBackoff
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		ch, sub, err := src.watch(ctx, filterer)
+		if err != nil {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			backoff *= 2
+			if backoff > watchMaxBackoff {
+				backoff = watchMaxBackoff
+			}
+			continue
+		}
+
+		stop := false
+		for !stop {
+			select {
+			case <-ctx.Done():
+				sub.Unsubscribe()
+				return
+			case subErr := <-sub.Err():
+				sub.Unsubscribe()
+				if ctx.Err() != nil {
+					return
+				}
+				if subErr != nil {
+					if head, herr := headFunc(ctx); herr == nil && head > lastSeen {
+						if caught, cerr := catchUpChunked(ctx, filterer, src, lastSeen+1, head); cerr == nil {
+							for _, ev := range caught {
+								if bn := ev.BlockNumber(); bn > lastSeen {
+									lastSeen = bn
+								}
+								select {
+								case intake <- ev:
+								case <-ctx.Done():
+									return
+								}
+							}
+						}
+					}
+				}
+				select {
+				case <-time.After(backoff):
+				case <-ctx.Done():
+					return
+				}
+				backoff *= 2
+				if backoff > watchMaxBackoff {
+					backoff = watchMaxBackoff
+				}
+				stop = true
+			case ev, ok := <-ch:
+				if !ok {
+					stop = true
+					continue
+				}
+				if bn := ev.BlockNumber(); bn > lastSeen {
+					lastSeen = bn
+				}
+				backoff = watchMinBackoff
+				select {
+				case intake <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// catchUpChunked replays src over [from, to] in catchUpChunkSize windows,
+// halving the window (down to catchUpMinChunkSize) whenever the provider
+// rejects a range as too large, the same way
+// rewardscoordinator.Subscriber's own backfill does.
+func catchUpChunked(ctx context.Context, filterer eventFilterer, src muxSource, from, to uint64) ([]RewardsEvent, error) {
+	window := uint64(catchUpChunkSize)
+	var out []RewardsEvent
+	for from <= to {
+		if ctx.Err() != nil {
+			return out, ctx.Err()
+		}
+		end := from + window - 1
+		if end > to {
+			end = to
+		}
+
+		evs, err := src.catchUp(ctx, filterer, from, end)
+		if err != nil {
+			if rpcerrors.IsRangeTooLarge(err) {
+				if window <= catchUpMinChunkSize {
+					return out, fmt.Errorf("error catching up blocks %d-%d: range still rejected at minimum chunk size %d: %w", from, end, catchUpMinChunkSize, err)
+				}
+				window /= 2
+				if window < catchUpMinChunkSize {
+					window = catchUpMinChunkSize
+				}
+				continue
+			}
+			return out, fmt.Errorf("error catching up blocks %d-%d: %w", from, end, err)
+		}
+
+		out = append(out, evs...)
+		from = end + 1
+	}
+	return out, nil
+}
+
+// muxLoop is the Multiplexer's ring buffer: it queues events received from
+// intake (up to bufferSize) and drains them to out in arrival order. When
+// the queue is full, overflow determines whether intake stops being
+// read - so a producer (and its underlying subscription) blocks on
+// delivery - or the oldest queued event is discarded to make room.
+func muxLoop(ctx context.Context, intake <-chan RewardsEvent, out chan<- RewardsEvent, bufferSize int, overflow OverflowPolicy) {
+	var queue []RewardsEvent
+	for {
+		full := bufferSize > 0 && len(queue) >= bufferSize
+
+		if full && overflow == BlockProducer {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- queue[0]:
+				queue = queue[1:]
+			}
+			continue
+		}
+
+		if len(queue) == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-intake:
+				if !ok {
+					return
+				}
+				queue = append(queue, ev)
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-intake:
+			if !ok {
+				intake = nil
+				continue
+			}
+			if full {
+				queue = append(queue[1:], ev)
+			} else {
+				queue = append(queue, ev)
+			}
+		case out <- queue[0]:
+			queue = queue[1:]
+		}
+	}
+}
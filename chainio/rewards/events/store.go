@@ -0,0 +1,46 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// CursorStore persists how far the Indexer has backfilled, keyed by event
+// name. The Indexer writes the same block to every tracked event's entry
+// and resumes from the furthest-along one, so the five events share a
+// single point of resumption rather than advancing independently.
+type CursorStore interface {
+	LoadCursor(ctx context.Context, eventName string) (block uint64, ok bool, err error)
+	SaveCursor(ctx context.Context, eventName string, block uint64) error
+}
+
+// MemoryCursorStore is an in-process CursorStore with no persistence
+// across restarts, suitable for tests or short-lived runs.
+type MemoryCursorStore struct {
+	mu      sync.Mutex
+	cursors map[string]uint64
+}
+
+// NewMemoryCursorStore returns an empty MemoryCursorStore.
+func NewMemoryCursorStore() *MemoryCursorStore {
+	return &MemoryCursorStore{cursors: make(map[string]uint64)}
+}
+
+// LoadCursor implements CursorStore.
+func (m *MemoryCursorStore) LoadCursor(ctx context.Context, eventName string) (uint64, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	block, ok := m.cursors[eventName]
+	return block, ok, nil
+}
+
+// SaveCursor implements CursorStore.
+func (m *MemoryCursorStore) SaveCursor(ctx context.Context, eventName string, block uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.cursors == nil {
+		m.cursors = make(map[string]uint64)
+	}
+	m.cursors[eventName] = block
+	return nil
+}
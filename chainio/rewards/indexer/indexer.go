@@ -0,0 +1,212 @@
+// Package indexer delivers three RewardsCoordinator events
+// (AVSRewardsSubmissionCreated, ClaimerForSet, ActivationDelaySet) to a
+// typed Sink through a backfill-then-watch run that lasts until its
+// context is canceled, on top of
+// chainio/subscriber/rewardscoordinator.Subscriber - which already does
+// the chunked, reorg-safe getLogs pagination this package used to
+// reimplement itself.
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// eventFilterer is the full RewardsCoordinator binding surface, identical
+// to rewardscoordinator's own (unexported) eventFilterer - redeclared
+// here so New can name it without depending on that package's internals.
+// Indexer only dispatches three of these events to its Sink, but the
+// underlying Subscriber needs all fourteen to multiplex its stream.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+// Sink receives strongly-typed events as the indexer walks the chain, in
+// ascending block order within each run. Run only calls a Handle* method
+// once an event is Config.Subscriber.Confirmations deep, which keeps
+// events from shallower reorgs away from Sink. Sink has no reorg callback:
+// if a handled event's block is reorged out later, Run drops the Reverted
+// re-delivery and Sink is not told.
+type Sink interface {
+	HandleAVSRewardsSubmissionCreated(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated) error
+	HandleClaimerForSet(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet) error
+	HandleActivationDelaySet(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) error
+}
+
+// CheckpointStore persists the last block this indexer has fully
+// processed, so a restart resumes instead of rescanning from genesis. The
+// interface is deliberately narrow so it can be backed by BoltDB,
+// Postgres, or - as MemoryCheckpointStore does - nothing durable at all.
+type CheckpointStore interface {
+	LastBlock(ctx context.Context) (block uint64, ok bool, err error)
+	SaveBlock(ctx context.Context, block uint64) error
+}
+
+// MemoryCheckpointStore is an in-process CheckpointStore with no
+// persistence across restarts, suitable for tests or short-lived runs.
+type MemoryCheckpointStore struct {
+	block uint64
+	ok    bool
+}
+
+// LastBlock implements CheckpointStore.
+func (m *MemoryCheckpointStore) LastBlock(ctx context.Context) (uint64, bool, error) {
+	return m.block, m.ok, nil
+}
+
+// SaveBlock implements CheckpointStore.
+func (m *MemoryCheckpointStore) SaveBlock(ctx context.Context, block uint64) error {
+	m.block = block
+	m.ok = true
+	return nil
+}
+
+// Config controls an Indexer's underlying Subscriber and checkpointing.
+type Config struct {
+	// StartBlock is used in place of the checkpoint store when it has no
+	// saved block yet.
+	StartBlock uint64
+	Subscriber rewardscoordinator.Config
+}
+
+// Indexer backfills RewardsCoordinator events into a Sink through a
+// rewardscoordinator.Subscriber, checkpointed for resumable replay.
+type Indexer struct {
+	sub    *rewardscoordinator.Subscriber
+	sink   Sink
+	store  CheckpointStore
+	config Config
+	logger logging.Logger
+}
+
+// New returns an Indexer reading from filterer and delivering to sink,
+// using store to track progress. filterer and headFunc are passed
+// straight through to a new rewardscoordinator.Subscriber.
+func New(filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), sink Sink, store CheckpointStore, config Config, logger logging.Logger) *Indexer {
+	return &Indexer{
+		sub:    rewardscoordinator.New(filterer, headFunc, config.Subscriber, logger),
+		sink:   sink,
+		store:  store,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run backfills from the checkpoint (or Config.StartBlock, if none is
+// saved yet) and then watches for new events until ctx is canceled,
+// delivering AVSRewardsSubmissionCreated, ClaimerForSet and
+// ActivationDelaySet events to the Sink and saving the checkpoint after
+// each one. Subscriber.Subscribe only takes a fromBlock, never a bound,
+// so unlike this package's pre-Subscriber implementation, Run no longer
+// takes a toBlock to backfill through and return at: there's no way to
+// tell "backfill reached head" apart from "no more matching events
+// arrived yet" from the delivered stream alone.
+func (idx *Indexer) Run(ctx context.Context) error {
+	from, ok, err := idx.store.LastBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading checkpoint: %w", err)
+	}
+	if !ok {
+		from = idx.config.StartBlock
+	} else {
+		from++
+	}
+
+	raw := make(chan rewardscoordinator.RewardsCoordinatorEvent)
+	sub, err := idx.sub.Subscribe(ctx, from, raw)
+	if err != nil {
+		return fmt.Errorf("error subscribing to RewardsCoordinator events: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			return fmt.Errorf("RewardsCoordinator subscription error: %w", err)
+		case ev, ok := <-raw:
+			if !ok {
+				return nil
+			}
+			if err := idx.handle(ctx, ev); err != nil {
+				return err
+			}
+			if !ev.Reverted {
+				if err := idx.store.SaveBlock(ctx, ev.BlockNumber); err != nil {
+					return fmt.Errorf("error saving checkpoint at block %d: %w", ev.BlockNumber, err)
+				}
+			}
+		}
+	}
+}
+
+func (idx *Indexer) handle(ctx context.Context, ev rewardscoordinator.RewardsCoordinatorEvent) error {
+	if ev.Reverted {
+		return nil
+	}
+	switch ev.Kind {
+	case rewardscoordinator.KindAVSRewardsSubmissionCreated:
+		if err := idx.sink.HandleAVSRewardsSubmissionCreated(ctx, ev.AVSRewardsSubmissionCreated); err != nil {
+			return fmt.Errorf("sink error handling AVSRewardsSubmissionCreated: %w", err)
+		}
+	case rewardscoordinator.KindClaimerForSet:
+		if err := idx.sink.HandleClaimerForSet(ctx, ev.ClaimerForSet); err != nil {
+			return fmt.Errorf("sink error handling ClaimerForSet: %w", err)
+		}
+	case rewardscoordinator.KindActivationDelaySet:
+		if err := idx.sink.HandleActivationDelaySet(ctx, ev.ActivationDelaySet); err != nil {
+			return fmt.Errorf("sink error handling ActivationDelaySet: %w", err)
+		}
+	}
+	return nil
+}
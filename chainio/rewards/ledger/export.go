@@ -0,0 +1,39 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ExportCSV writes entries to w, one row per reconciliation Entry, so
+// operators can audit split behavior and outstanding balances outside of
+// a Go program (e.g. in a spreadsheet or loaded into a warehouse table).
+// A Parquet exporter isn't included since this module doesn't vendor a
+// Parquet encoder; CSV covers the same export use case without adding
+// one.
+func ExportCSV(w io.Writer, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	header := []string{"avs", "operator", "token", "promised", "claimed", "outstanding", "avsSplitBips", "avsSplitKnown"}
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("error writing csv header: %w", err)
+	}
+	for _, e := range entries {
+		row := []string{
+			e.Key.AVS.Hex(),
+			e.Key.Operator.Hex(),
+			e.Key.Token.Hex(),
+			e.Promised.String(),
+			e.Claimed.String(),
+			e.Outstanding.String(),
+			strconv.FormatUint(uint64(e.AVSSplitBips), 10),
+			strconv.FormatBool(e.AVSSplitKnown),
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("error writing csv row for %s/%s/%s: %w", e.Key.AVS, e.Key.Operator, e.Key.Token, err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
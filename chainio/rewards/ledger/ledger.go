@@ -0,0 +1,265 @@
+// Package ledger joins the events package's OperatorDirectedAVSRewardsSubmissionCreated,
+// RewardsClaimed, OperatorAVSSplitBipsSet, and OperatorPISplitBipsSet
+// streams into a per-(AVS, operator, token) reconciliation: what
+// submissions have promised an operator versus what's actually been
+// claimed against it, alongside the split bips in effect at any given
+// moment. It exists so an AVS or operator can audit their own payout
+// pipeline without re-deriving the join themselves from raw logs.
+package ledger
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rewards/events"
+)
+
+// Key identifies one reconciliation row.
+type Key struct {
+	AVS      common.Address
+	Operator common.Address
+	Token    common.Address
+}
+
+// Allocation is one CALCULATION_INTERVAL_SECONDS-bucketed slice of a
+// submission's promised principal, keyed by the interval's start
+// timestamp.
+type Allocation struct {
+	IntervalStart uint32
+	Amount        *big.Int
+}
+
+// Entry is one Key's reconciliation as of a Snapshot.
+type Entry struct {
+	Key         Key
+	Promised    *big.Int
+	Claimed     *big.Int
+	Outstanding *big.Int
+	Allocations []Allocation
+
+	// AVSSplitBips and AVSSplitKnown report the OperatorAVSSplitBips in
+	// effect for this AVS/operator pair at the Snapshot's timestamp.
+	// AVSSplitKnown is false if no OperatorAVSSplitBipsSet activating at
+	// or before that timestamp has been ingested.
+	AVSSplitBips  uint16
+	AVSSplitKnown bool
+}
+
+type avsOperatorKey struct {
+	avs      common.Address
+	operator common.Address
+}
+
+type submission struct {
+	avs      common.Address
+	operator common.Address
+	token    common.Address
+	amount   *big.Int
+	start    uint32
+	blockNum uint64
+}
+
+type claim struct {
+	earner   common.Address
+	token    common.Address
+	amount   *big.Int
+	blockNum uint64
+}
+
+type splitChange struct {
+	activatedAt uint32
+	bips        uint16
+}
+
+// Ledger accumulates submissions, claims, and split changes ingested from
+// an events.Indexer and reconciles them on demand via Snapshot.
+type Ledger struct {
+	calcIntervalSeconds uint32
+
+	mu          sync.Mutex
+	submissions []submission
+	claims      []claim
+	avsSplits   map[avsOperatorKey][]splitChange
+	piSplits    map[common.Address][]splitChange
+}
+
+// New returns an empty Ledger that buckets submission allocations into
+// calcIntervalSeconds windows, matching the RewardsCoordinator's
+// CALCULATION_INTERVAL_SECONDS.
+func New(calcIntervalSeconds uint32) *Ledger {
+	if calcIntervalSeconds == 0 {
+		calcIntervalSeconds = 1
+	}
+	return &Ledger{
+		calcIntervalSeconds: calcIntervalSeconds,
+		avsSplits:           make(map[avsOperatorKey][]splitChange),
+		piSplits:            make(map[common.Address][]splitChange),
+	}
+}
+
+// Ingest folds one event from the events package into the ledger.
+// RewardsForAllSubmitterSet isn't reconciled by anything here and is
+// silently ignored, so callers can feed an Indexer's channel through
+// unfiltered.
+func (l *Ledger) Ingest(ev events.Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	switch ev.Kind {
+	case events.KindOperatorDirectedAVSRewardsSubmissionCreated:
+		l.ingestSubmission(ev)
+	case events.KindRewardsClaimed:
+		l.ingestClaim(ev)
+	case events.KindOperatorAVSSplitBipsSet:
+		l.ingestAVSSplit(ev)
+	case events.KindOperatorPISplitBipsSet:
+		l.ingestPISplit(ev)
+	}
+}
+
+func (l *Ledger) ingestSubmission(ev events.Event) {
+	created := ev.OperatorDirectedAVSRewardsSubmissionCreated
+	if created == nil {
+		return
+	}
+	sub := created.OperatorDirectedRewardsSubmission
+	for _, r := range sub.OperatorRewards {
+		l.submissions = append(l.submissions, submission{
+			avs:      created.Avs,
+			operator: r.Operator,
+			token:    sub.Token,
+			amount:   new(big.Int).Set(r.Amount),
+			start:    sub.StartTimestamp,
+			blockNum: ev.BlockNumber,
+		})
+	}
+}
+
+func (l *Ledger) ingestClaim(ev events.Event) {
+	c := ev.RewardsClaimed
+	if c == nil {
+		return
+	}
+	l.claims = append(l.claims, claim{
+		earner:   c.Earner,
+		token:    c.Token,
+		amount:   new(big.Int).Set(c.ClaimedAmount),
+		blockNum: ev.BlockNumber,
+	})
+}
+
+func (l *Ledger) ingestAVSSplit(ev events.Event) {
+	s := ev.OperatorAVSSplitBipsSet
+	if s == nil {
+		return
+	}
+	key := avsOperatorKey{avs: s.Avs, operator: s.Operator}
+	l.avsSplits[key] = append(l.avsSplits[key], splitChange{activatedAt: s.ActivatedAt, bips: s.NewOperatorAVSSplitBips})
+}
+
+func (l *Ledger) ingestPISplit(ev events.Event) {
+	s := ev.OperatorPISplitBipsSet
+	if s == nil {
+		return
+	}
+	l.piSplits[s.Operator] = append(l.piSplits[s.Operator], splitChange{activatedAt: s.ActivatedAt, bips: s.NewOperatorPISplitBips})
+}
+
+// splitAt returns the bips change with the latest ActivatedAt at or
+// before ts - the split rate actually in effect at ts, matching the
+// contract's activation-delay semantics where a newly set split only
+// takes effect once its ActivatedAt timestamp is reached.
+func splitAt(changes []splitChange, ts uint32) (uint16, bool) {
+	best := -1
+	for i, c := range changes {
+		if c.activatedAt <= ts && (best == -1 || changes[best].activatedAt < c.activatedAt) {
+			best = i
+		}
+	}
+	if best == -1 {
+		return 0, false
+	}
+	return changes[best].bips, true
+}
+
+// OperatorPISplitAt returns the OperatorPISplitBips in effect for
+// operator at ts, from the OperatorPISplitBipsSet history ingested so
+// far. It's exposed separately from Snapshot since the programmatic
+// incentives split isn't keyed by AVS or token the way Entry's rows are.
+func (l *Ledger) OperatorPISplitAt(operator common.Address, ts uint32) (uint16, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return splitAt(l.piSplits[operator], ts)
+}
+
+// Snapshot reconciles every submission and claim observed at or before
+// atBlock into one Entry per (AVS, operator, token). atTimestamp is the
+// unix timestamp of atBlock - the Ledger only sees event logs, not block
+// timestamps, so callers resolve it themselves (e.g. from the block
+// header) - and selects which AVS split bips were active at that moment.
+//
+// A claim is matched to every entry whose operator equals the claim's
+// earner and whose token matches; if that operator has submissions from
+// more than one AVS in the same token, the single claimed amount can't be
+// attributed to one of them from the log alone, so it's applied to all of
+// them. Outstanding is therefore a lower bound on a given AVS's unclaimed
+// principal in that case, not an exact figure.
+func (l *Ledger) Snapshot(atBlock uint64, atTimestamp uint32) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := make(map[Key]*Entry)
+	get := func(k Key) *Entry {
+		e, ok := entries[k]
+		if !ok {
+			e = &Entry{Key: k, Promised: new(big.Int), Claimed: new(big.Int), Outstanding: new(big.Int)}
+			entries[k] = e
+		}
+		return e
+	}
+
+	for _, s := range l.submissions {
+		if s.blockNum > atBlock {
+			continue
+		}
+		e := get(Key{AVS: s.avs, Operator: s.operator, Token: s.token})
+		e.Promised.Add(e.Promised, s.amount)
+		bucket := s.start - s.start%l.calcIntervalSeconds
+		e.Allocations = append(e.Allocations, Allocation{IntervalStart: bucket, Amount: new(big.Int).Set(s.amount)})
+	}
+
+	for _, c := range l.claims {
+		if c.blockNum > atBlock {
+			continue
+		}
+		for k, e := range entries {
+			if k.Operator == c.earner && k.Token == c.token {
+				e.Claimed.Add(e.Claimed, c.amount)
+			}
+		}
+	}
+
+	result := make([]Entry, 0, len(entries))
+	for key, e := range entries {
+		if bips, ok := splitAt(l.avsSplits[avsOperatorKey{avs: key.AVS, operator: key.Operator}], atTimestamp); ok {
+			e.AVSSplitBips = bips
+			e.AVSSplitKnown = true
+		}
+		e.Outstanding.Sub(e.Promised, e.Claimed)
+		sort.Slice(e.Allocations, func(i, j int) bool { return e.Allocations[i].IntervalStart < e.Allocations[j].IntervalStart })
+		result = append(result, *e)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Key.AVS != result[j].Key.AVS {
+			return bytes.Compare(result[i].Key.AVS.Bytes(), result[j].Key.AVS.Bytes()) < 0
+		}
+		if result[i].Key.Operator != result[j].Key.Operator {
+			return bytes.Compare(result[i].Key.Operator.Bytes(), result[j].Key.Operator.Bytes()) < 0
+		}
+		return bytes.Compare(result[i].Key.Token.Bytes(), result[j].Key.Token.Bytes()) < 0
+	})
+	return result
+}
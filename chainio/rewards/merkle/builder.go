@@ -0,0 +1,101 @@
+// Package merkle is the rewards-updater side counterpart to SubmitRoot:
+// given per-earner, per-token cumulative reward tuples for one
+// rewardsCalculationEndTimestamp, it builds the same two-level Merkle
+// tree the RewardsCoordinator verifies, computes the resulting
+// distributionRoot, and later produces the RewardsMerkleClaim proofs
+// earners need to redeem. The underlying tree construction lives in
+// chainio/clients/elcontracts/rewardsmerkle; this package adds the
+// updater-facing persistence so a root can be computed on one machine and
+// proofs served from another.
+package merkle
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/elcontracts/rewardsmerkle"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// RewardsMerkleClaim is a convenience alias for the generated struct, so
+// callers of this package don't need to import the bindings package
+// directly.
+type RewardsMerkleClaim = contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+
+// Earning is a convenience alias for the underlying tree package's
+// (token, cumulativeEarnings) pair.
+type Earning = rewardsmerkle.Earning
+
+// Builder holds the built tree for one rewardsCalculationEndTimestamp
+// along with the raw entries it was built from, so it can be persisted
+// and reloaded to serve proofs without recomputing the rewards
+// calculation itself.
+type Builder struct {
+	timestamp uint32
+	entries   map[common.Address][]Earning
+	tree      *rewardsmerkle.ClaimTree
+}
+
+// NewBuilder builds the two-level tree for entries - per-earner, per-token
+// cumulative earnings as of timestamp - ready to compute a
+// distributionRoot and generate claim proofs.
+func NewBuilder(timestamp uint32, entries map[common.Address][]Earning) (*Builder, error) {
+	tree, err := rewardsmerkle.BuildClaimTree(entries)
+	if err != nil {
+		return nil, fmt.Errorf("error building claim tree for timestamp %d: %w", timestamp, err)
+	}
+	return &Builder{timestamp: timestamp, entries: entries, tree: tree}, nil
+}
+
+// Timestamp returns the rewardsCalculationEndTimestamp this Builder's
+// tree was computed for.
+func (b *Builder) Timestamp() uint32 {
+	return b.timestamp
+}
+
+// ComputeDistributionRoot returns the root to hand to
+// SubmitRoot(root, rewardsCalculationEndTimestamp).
+func (b *Builder) ComputeDistributionRoot() [32]byte {
+	return b.tree.Root()
+}
+
+// GenerateClaimProof returns a RewardsMerkleClaim for earner restricted to
+// tokens, with RootIndex left at zero for the caller to fill in once the
+// root has been posted and its index is known via GetRootIndexFromHash. If
+// tokens is empty, the claim covers every token the earner has in this
+// tree, matching ClaimTree.ProveEarner.
+func (b *Builder) GenerateClaimProof(earner common.Address, tokens ...common.Address) (RewardsMerkleClaim, error) {
+	claim, err := b.tree.ProveEarner(earner)
+	if err != nil {
+		return RewardsMerkleClaim{}, fmt.Errorf("error proving earner %s: %w", earner, err)
+	}
+	if len(tokens) == 0 {
+		return claim, nil
+	}
+
+	want := make(map[common.Address]bool, len(tokens))
+	for _, t := range tokens {
+		want[t] = true
+	}
+
+	var indices []uint32
+	var proofs [][]byte
+	var leaves []contractIRewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf
+	for i, leaf := range claim.TokenLeaves {
+		if !want[leaf.Token] {
+			continue
+		}
+		indices = append(indices, claim.TokenIndices[i])
+		proofs = append(proofs, claim.TokenTreeProofs[i])
+		leaves = append(leaves, leaf)
+	}
+	if len(leaves) != len(tokens) {
+		return RewardsMerkleClaim{}, fmt.Errorf("earner %s is missing one or more of the requested tokens in this tree", earner)
+	}
+
+	claim.TokenIndices = indices
+	claim.TokenTreeProofs = proofs
+	claim.TokenLeaves = leaves
+	return claim, nil
+}
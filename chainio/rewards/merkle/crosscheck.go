@@ -0,0 +1,60 @@
+package merkle
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/elcontracts/rewardsmerkle"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// ClaimedLog is the subset of a RewardsClaimed event CrossCheckClaimedLog
+// needs, so callers don't have to depend on a specific event-indexing
+// package to use it.
+type ClaimedLog struct {
+	Root   [32]byte
+	Earner common.Address
+	Token  common.Address
+}
+
+// CrossCheckClaimedLog asserts that a historical RewardsClaimed log is
+// consistent with b's locally built tree: that log.Root matches the root b
+// computed, and that log.Earner has a leaf for log.Token somewhere under
+// that root. It does not compare log's claimed amount against the tree's
+// cumulative earnings for that token, since RewardsClaimed reports the
+// amount actually transferred - the delta since the earner's last claim,
+// not the cumulative figure the leaf commits to.
+func CrossCheckClaimedLog(b *Builder, log ClaimedLog) error {
+	root := b.ComputeDistributionRoot()
+	if root != log.Root {
+		return fmt.Errorf("builder root %x does not match claimed log's root %x", root, log.Root)
+	}
+
+	claim, err := b.tree.ProveEarner(log.Earner)
+	if err != nil {
+		return fmt.Errorf("error proving earner %s: %w", log.Earner, err)
+	}
+
+	found := false
+	for _, leaf := range claim.TokenLeaves {
+		if leaf.Token == log.Token {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("earner %s has no leaf for token %s under root %x", log.Earner, log.Token, root)
+	}
+
+	if !rewardsmerkle.VerifyClaim(claim, root) {
+		return fmt.Errorf("reconstructed claim for earner %s does not verify under root %x", log.Earner, root)
+	}
+	return nil
+}
+
+// ClaimedLogFromEvent converts a generated RewardsClaimed event into a
+// ClaimedLog.
+func ClaimedLogFromEvent(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed) ClaimedLog {
+	return ClaimedLog{Root: ev.Root, Earner: ev.Earner, Token: ev.Token}
+}
@@ -0,0 +1,109 @@
+package merkle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// persistedTokenEarning is one earner's per-token line in the on-disk
+// format.
+type persistedTokenEarning struct {
+	Token              common.Address `json:"token"`
+	CumulativeEarnings string         `json:"cumulativeEarnings"`
+}
+
+// persistedEarner is one earner's full record in the on-disk format.
+type persistedEarner struct {
+	Earner common.Address          `json:"earner"`
+	Tokens []persistedTokenEarning `json:"tokens"`
+}
+
+// persistedTree is the full on-disk format: the raw entries a Builder was
+// constructed from, plus the timestamp they were computed for. The tree
+// itself isn't serialized - it's cheaply rebuilt from these entries on
+// load, which also re-verifies that the entries still produce the root
+// the file claims.
+type persistedTree struct {
+	RewardsCalculationEndTimestamp uint32            `json:"rewardsCalculationEndTimestamp"`
+	DistributionRoot               string            `json:"distributionRoot"`
+	Earners                        []persistedEarner `json:"earners"`
+}
+
+// Save writes b's entries and timestamp to w in the on-disk JSON format,
+// so a caller can compute the root on one machine and reload it elsewhere
+// to serve proofs without recomputing the underlying rewards calculation.
+func (b *Builder) Save(w io.Writer) error {
+	out := persistedTree{
+		RewardsCalculationEndTimestamp: b.timestamp,
+		DistributionRoot:               fmt.Sprintf("%x", b.ComputeDistributionRoot()),
+	}
+	for earner, earnings := range b.entries {
+		tokens := make([]persistedTokenEarning, len(earnings))
+		for i, e := range earnings {
+			tokens[i] = persistedTokenEarning{Token: e.Token, CumulativeEarnings: e.CumulativeEarnings.String()}
+		}
+		out.Earners = append(out.Earners, persistedEarner{Earner: earner, Tokens: tokens})
+	}
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		return fmt.Errorf("error encoding persisted tree: %w", err)
+	}
+	return nil
+}
+
+// SaveToFile is a convenience wrapper around Save that writes to path.
+func (b *Builder) SaveToFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %w", path, err)
+	}
+	defer f.Close()
+	return b.Save(f)
+}
+
+// Load rebuilds a Builder from the on-disk JSON format produced by Save,
+// verifying that the reconstructed tree's root still matches the one
+// recorded at save time - guarding against a corrupted or hand-edited
+// file silently serving the wrong proofs.
+func Load(r io.Reader) (*Builder, error) {
+	var in persistedTree
+	if err := json.NewDecoder(r).Decode(&in); err != nil {
+		return nil, fmt.Errorf("error decoding persisted tree: %w", err)
+	}
+
+	entries := make(map[common.Address][]Earning, len(in.Earners))
+	for _, e := range in.Earners {
+		earnings := make([]Earning, 0, len(e.Tokens))
+		for _, t := range e.Tokens {
+			amount, ok := new(big.Int).SetString(t.CumulativeEarnings, 10)
+			if !ok {
+				return nil, fmt.Errorf("error parsing cumulativeEarnings %q for earner %s token %s", t.CumulativeEarnings, e.Earner, t.Token)
+			}
+			earnings = append(earnings, Earning{Token: t.Token, CumulativeEarnings: amount})
+		}
+		entries[e.Earner] = earnings
+	}
+
+	b, err := NewBuilder(in.RewardsCalculationEndTimestamp, entries)
+	if err != nil {
+		return nil, err
+	}
+	if got := fmt.Sprintf("%x", b.ComputeDistributionRoot()); in.DistributionRoot != "" && got != in.DistributionRoot {
+		return nil, fmt.Errorf("reconstructed root %s does not match persisted root %s; file may be corrupted", got, in.DistributionRoot)
+	}
+	return b, nil
+}
+
+// LoadFromFile is a convenience wrapper around Load that reads from path.
+func LoadFromFile(path string) (*Builder, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", path, err)
+	}
+	defer f.Close()
+	return Load(f)
+}
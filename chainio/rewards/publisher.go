@@ -0,0 +1,388 @@
+// Package rewards provides a long-running RewardsPublisher that automates
+// the cron job most AVSs end up hand-rolling around the RewardsCoordinator's
+// CreateAVSRewardsSubmission/CreateOperatorDirectedAVSRewardsSubmission
+// methods: on a configurable schedule it pulls the submissions for the next
+// epoch from a pluggable RewardsSource, tops up ERC20 allowances for
+// operator-directed submissions, retries
+// with EIP-1559 fee bumping, and reports structured results via callbacks.
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// RewardsSubmission and OperatorDirectedRewardsSubmission are convenience
+// aliases for the generated structs, so callers of this package don't need
+// to import the bindings package directly.
+type RewardsSubmission = contractIRewardsCoordinator.IRewardsCoordinatorRewardsSubmission
+type OperatorDirectedRewardsSubmission = contractIRewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission
+
+// RewardsSource supplies the submissions due for the current epoch. Either
+// method may return an empty slice if the AVS has nothing to submit that
+// epoch; the publisher treats that as a no-op rather than an error.
+type RewardsSource interface {
+	RegularSubmissions(ctx context.Context, avs common.Address) ([]RewardsSubmission, error)
+	OperatorDirectedSubmissions(ctx context.Context, avs common.Address) ([]OperatorDirectedRewardsSubmission, error)
+}
+
+// rewardsTransactor is the subset of RewardsCoordinator write methods the
+// publisher drives.
+type rewardsTransactor interface {
+	CreateAVSRewardsSubmission(opts *bind.TransactOpts, submissions []RewardsSubmission) (*types.Transaction, error)
+	CreateOperatorDirectedAVSRewardsSubmission(
+		opts *bind.TransactOpts,
+		avs common.Address,
+		submissions []OperatorDirectedRewardsSubmission,
+	) (*types.Transaction, error)
+}
+
+// erc20Caller/erc20Transactor are the subset of a generated ERC20 binding's
+// methods needed to check and raise allowances before submitting rewards.
+type erc20Caller interface {
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+}
+
+type erc20Transactor interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// Schedule decides when the next epoch publish is due, given the time of
+// the last one (or the zero time on first run). It's deliberately minimal
+// so callers can plug in a fixed interval, a cron expression evaluator, or
+// anything else that can answer "when next".
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+// IntervalSchedule is a Schedule that fires at a fixed interval.
+type IntervalSchedule time.Duration
+
+// Next implements Schedule.
+func (s IntervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(s))
+}
+
+// epochKey identifies one already-submitted epoch for a given strategy, so
+// RewardsPublisher can skip it on restart or retry instead of resubmitting
+// and reverting on-chain.
+type epochKey struct {
+	strategy  common.Address
+	token     common.Address
+	startTime uint32
+	duration  uint32
+}
+
+// ResultCallback is invoked after each publish attempt, whether it
+// succeeded or failed. kind is "regular" or "operator-directed".
+type ResultCallback func(avs common.Address, kind string, txs []*types.Transaction, err error)
+
+// PublisherConfig customizes RewardsPublisher's retry and allowance
+// behavior.
+type PublisherConfig struct {
+	// MaxRetries is how many additional attempts are made after a
+	// submission's first failure. Zero disables retries.
+	MaxRetries int
+	// FeeBumpNumerator/FeeBumpDenominator scale GasFeeCap/GasTipCap
+	// between retries, e.g. 11/10 for a 10% bump. Defaults to 11/10 if
+	// either is zero.
+	FeeBumpNumerator   int64
+	FeeBumpDenominator int64
+	// RetryBackoff is the delay before each retry. Zero retries
+	// immediately.
+	RetryBackoff time.Duration
+}
+
+func (c PublisherConfig) bumpFactor() (int64, int64) {
+	if c.FeeBumpNumerator == 0 || c.FeeBumpDenominator == 0 {
+		return 11, 10
+	}
+	return c.FeeBumpNumerator, c.FeeBumpDenominator
+}
+
+// RewardsPublisher automates submitting an AVS's rewards each epoch: it
+// pulls submissions from a RewardsSource, tops up ERC20 allowances against
+// the RewardsCoordinator, and calls CreateAVSRewardsSubmission /
+// CreateOperatorDirectedAVSRewardsSubmission with EIP-1559 fee-bumping
+// retries, skipping any epoch it has already published. Allowances are
+// only checked and topped up for operator-directed submissions; regular
+// submissions are sent against whatever allowance is already in place.
+type RewardsPublisher struct {
+	avs            common.Address
+	rewardsAddress common.Address
+	source         RewardsSource
+	transactor     rewardsTransactor
+	newERC20       func(token common.Address) (erc20Caller, erc20Transactor, error)
+	newOpts        func(ctx context.Context) (*bind.TransactOpts, error)
+	config         PublisherConfig
+	logger         logging.Logger
+	onResult       ResultCallback
+
+	mu        sync.Mutex
+	published map[epochKey]bool
+}
+
+// NewRewardsPublisher returns a RewardsPublisher for avs. newOpts is called
+// before every submission attempt (including retries) to get a fresh,
+// signed *bind.TransactOpts, so callers can thread through their own
+// nonce/signing logic. onResult may be nil.
+func NewRewardsPublisher(
+	avs common.Address,
+	rewardsAddress common.Address,
+	source RewardsSource,
+	transactor rewardsTransactor,
+	newERC20 func(token common.Address) (erc20Caller, erc20Transactor, error),
+	newOpts func(ctx context.Context) (*bind.TransactOpts, error),
+	config PublisherConfig,
+	logger logging.Logger,
+	onResult ResultCallback,
+) *RewardsPublisher {
+	return &RewardsPublisher{
+		avs:            avs,
+		rewardsAddress: rewardsAddress,
+		source:         source,
+		transactor:     transactor,
+		newERC20:       newERC20,
+		newOpts:        newOpts,
+		config:         config,
+		logger:         logger,
+		onResult:       onResult,
+		published:      make(map[epochKey]bool),
+	}
+}
+
+// Run blocks, publishing an epoch each time schedule fires, until ctx is
+// canceled.
+func (p *RewardsPublisher) Run(ctx context.Context, schedule Schedule) error {
+	next := schedule.Next(time.Time{})
+	for {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case fired := <-timer.C:
+			p.PublishOnce(ctx)
+			next = schedule.Next(fired)
+		}
+	}
+}
+
+// PublishOnce pulls the current epoch's submissions from the RewardsSource
+// and submits any that haven't already been published, logging and
+// invoking onResult for each kind independently so one failing doesn't
+// block the other.
+func (p *RewardsPublisher) PublishOnce(ctx context.Context) {
+	regular, err := p.source.RegularSubmissions(ctx, p.avs)
+	if err != nil {
+		p.logger.Error("Error fetching regular rewards submissions", "avs", p.avs, "error", err)
+		p.report("regular", nil, fmt.Errorf("error fetching submissions: %w", err))
+	} else if fresh := p.filterFresh(regular); len(fresh) > 0 {
+		txs, err := p.submitWithRetry(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return p.transactor.CreateAVSRewardsSubmission(opts, fresh)
+		}, "regular")
+		if err == nil {
+			p.markPublished(fresh)
+		}
+		p.report("regular", txs, err)
+	}
+
+	operatorDirected, err := p.source.OperatorDirectedSubmissions(ctx, p.avs)
+	if err != nil {
+		p.logger.Error("Error fetching operator-directed rewards submissions", "avs", p.avs, "error", err)
+		p.report("operator-directed", nil, fmt.Errorf("error fetching submissions: %w", err))
+		return
+	}
+	freshOD := p.filterFreshOperatorDirected(operatorDirected)
+	if len(freshOD) == 0 {
+		return
+	}
+	if err := p.ensureAllowances(ctx, freshOD); err != nil {
+		p.logger.Error("Error ensuring operator-directed rewards allowance", "avs", p.avs, "error", err)
+		p.report("operator-directed", nil, err)
+		return
+	}
+	txs, err := p.submitWithRetry(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return p.transactor.CreateOperatorDirectedAVSRewardsSubmission(opts, p.avs, freshOD)
+	}, "operator-directed")
+	if err == nil {
+		p.markPublishedOperatorDirected(freshOD)
+	}
+	p.report("operator-directed", txs, err)
+}
+
+func (p *RewardsPublisher) report(kind string, tx *types.Transaction, err error) {
+	if p.onResult == nil {
+		return
+	}
+	var txs []*types.Transaction
+	if tx != nil {
+		txs = []*types.Transaction{tx}
+	}
+	p.onResult(p.avs, kind, txs, err)
+}
+
+// filterFresh drops submissions whose (strategy, token, start, duration)
+// has already been published, per-strategy since a single submission can
+// bundle several strategies.
+func (p *RewardsPublisher) filterFresh(submissions []RewardsSubmission) []RewardsSubmission {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var fresh []RewardsSubmission
+	for _, s := range submissions {
+		allSeen := true
+		for _, sm := range s.StrategiesAndMultipliers {
+			key := epochKey{strategy: sm.Strategy, token: s.Token, startTime: s.StartTimestamp, duration: s.Duration}
+			if !p.published[key] {
+				allSeen = false
+				break
+			}
+		}
+		if !allSeen {
+			fresh = append(fresh, s)
+		}
+	}
+	return fresh
+}
+
+func (p *RewardsPublisher) markPublished(submissions []RewardsSubmission) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, s := range submissions {
+		for _, sm := range s.StrategiesAndMultipliers {
+			key := epochKey{strategy: sm.Strategy, token: s.Token, startTime: s.StartTimestamp, duration: s.Duration}
+			p.published[key] = true
+		}
+	}
+}
+
+func (p *RewardsPublisher) filterFreshOperatorDirected(submissions []OperatorDirectedRewardsSubmission) []OperatorDirectedRewardsSubmission {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var fresh []OperatorDirectedRewardsSubmission
+	for _, s := range submissions {
+		allSeen := true
+		for _, sm := range s.StrategiesAndMultipliers {
+			key := epochKey{strategy: sm.Strategy, token: s.Token, startTime: s.StartTimestamp, duration: s.Duration}
+			if !p.published[key] {
+				allSeen = false
+				break
+			}
+		}
+		if !allSeen {
+			fresh = append(fresh, s)
+		}
+	}
+	return fresh
+}
+
+func (p *RewardsPublisher) markPublishedOperatorDirected(submissions []OperatorDirectedRewardsSubmission) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, s := range submissions {
+		for _, sm := range s.StrategiesAndMultipliers {
+			key := epochKey{strategy: sm.Strategy, token: s.Token, startTime: s.StartTimestamp, duration: s.Duration}
+			p.published[key] = true
+		}
+	}
+}
+
+// ensureAllowances tops up the allowance for every distinct token across
+// submissions to cover the sum of its OperatorRewards, issuing an approve
+// transaction when the current allowance is insufficient.
+func (p *RewardsPublisher) ensureAllowances(ctx context.Context, submissions []OperatorDirectedRewardsSubmission) error {
+	need := make(map[common.Address]*big.Int)
+	for _, s := range submissions {
+		total := need[s.Token]
+		if total == nil {
+			total = new(big.Int)
+			need[s.Token] = total
+		}
+		for _, r := range s.OperatorRewards {
+			total.Add(total, r.Amount)
+		}
+	}
+	for token, amount := range need {
+		opts, err := p.newOpts(ctx)
+		if err != nil {
+			return fmt.Errorf("error building transact opts for approve: %w", err)
+		}
+		caller, transactor, err := p.newERC20(token)
+		if err != nil {
+			return fmt.Errorf("error getting ERC20 binding for token %s: %w", token, err)
+		}
+		allowance, err := caller.Allowance(&bind.CallOpts{Context: ctx}, opts.From, p.rewardsAddress)
+		if err != nil {
+			return fmt.Errorf("error reading allowance for token %s: %w", token, err)
+		}
+		if allowance.Cmp(amount) >= 0 {
+			continue
+		}
+		p.logger.Info("Approving RewardsCoordinator to spend token", "token", token, "amount", amount)
+		tx, err := transactor.Approve(opts, p.rewardsAddress, amount)
+		if err != nil {
+			return fmt.Errorf("error approving token %s: %w", token, err)
+		}
+		p.logger.Debug("Submitted approve transaction", "token", token, "txHash", tx.Hash())
+	}
+	return nil
+}
+
+// submitWithRetry calls send, bumping opts' EIP-1559 fee fields by the
+// configured factor and retrying up to MaxRetries times on error.
+func (p *RewardsPublisher) submitWithRetry(
+	ctx context.Context,
+	send func(opts *bind.TransactOpts) (*types.Transaction, error),
+	kind string,
+) (*types.Transaction, error) {
+	num, den := p.config.bumpFactor()
+	var lastErr error
+	for attempt := 0; attempt <= p.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if p.config.RetryBackoff > 0 {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(p.config.RetryBackoff):
+				}
+			}
+			p.logger.Warn("Retrying rewards submission", "avs", p.avs, "kind", kind, "attempt", attempt, "error", lastErr)
+		}
+		opts, err := p.newOpts(ctx)
+		if err != nil {
+			lastErr = fmt.Errorf("error building transact opts: %w", err)
+			continue
+		}
+		if attempt > 0 {
+			bumpFeeFields(opts, num, den)
+		}
+		tx, err := send(opts)
+		if err == nil {
+			p.logger.Info("Submitted rewards", "avs", p.avs, "kind", kind, "txHash", tx.Hash())
+			return tx, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("error submitting %s rewards for %s after %d attempts: %w", kind, p.avs, p.config.MaxRetries+1, lastErr)
+}
+
+// bumpFeeFields scales opts.GasFeeCap/GasTipCap by num/den in place, for a
+// retry that needs a higher fee to replace a stuck submission.
+func bumpFeeFields(opts *bind.TransactOpts, num, den int64) {
+	if opts.GasFeeCap != nil {
+		opts.GasFeeCap = new(big.Int).Div(new(big.Int).Mul(opts.GasFeeCap, big.NewInt(num)), big.NewInt(den))
+	}
+	if opts.GasTipCap != nil {
+		opts.GasTipCap = new(big.Int).Div(new(big.Int).Mul(opts.GasTipCap, big.NewInt(num)), big.NewInt(den))
+	}
+}
@@ -0,0 +1,35 @@
+package vesting
+
+import (
+	"context"
+	"fmt"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// Drift is a Schedule the Ledger predicted would be fully vested by a
+// landed root's RewardsCalculationEndTimestamp.
+type Drift struct {
+	Schedule Schedule
+}
+
+// ReconcileDistributionRoot returns every Schedule that should be fully
+// vested as of root's RewardsCalculationEndTimestamp, for a caller to
+// check against that root's actual Merkle tree (e.g. via
+// chainio/rewards/merkle). The Ledger only has each submission's promised
+// amount and unlock window, not the root's leaves, so this can only flag
+// which schedules a root ought to account for - it can't compare amounts
+// itself.
+func (l *Ledger) ReconcileDistributionRoot(ctx context.Context, root *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted) ([]Drift, error) {
+	schedules, err := l.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing vesting schedules: %w", err)
+	}
+	var drift []Drift
+	for _, s := range schedules {
+		if s.StartTimestamp+s.Duration <= root.RewardsCalculationEndTimestamp {
+			drift = append(drift, Drift{Schedule: s})
+		}
+	}
+	return drift, nil
+}
@@ -0,0 +1,57 @@
+package vesting
+
+import (
+	"context"
+	"sync"
+)
+
+// KVStore persists vesting Schedules keyed by Key, so ingesting the same
+// submission event twice - e.g. after a backfill re-delivers it - just
+// overwrites the same entry instead of double-counting it.
+type KVStore interface {
+	Save(ctx context.Context, key Key, schedule Schedule) error
+	Load(ctx context.Context, key Key) (Schedule, bool, error)
+	List(ctx context.Context) ([]Schedule, error)
+}
+
+// MemoryKVStore is an in-process KVStore with no persistence across
+// restarts, suitable for tests or short-lived runs.
+type MemoryKVStore struct {
+	mu        sync.Mutex
+	schedules map[Key]Schedule
+}
+
+// NewMemoryKVStore returns an empty MemoryKVStore.
+func NewMemoryKVStore() *MemoryKVStore {
+	return &MemoryKVStore{schedules: make(map[Key]Schedule)}
+}
+
+// Save implements KVStore.
+func (m *MemoryKVStore) Save(ctx context.Context, key Key, schedule Schedule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.schedules == nil {
+		m.schedules = make(map[Key]Schedule)
+	}
+	m.schedules[key] = schedule
+	return nil
+}
+
+// Load implements KVStore.
+func (m *MemoryKVStore) Load(ctx context.Context, key Key) (Schedule, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	schedule, ok := m.schedules[key]
+	return schedule, ok, nil
+}
+
+// List implements KVStore.
+func (m *MemoryKVStore) List(ctx context.Context) ([]Schedule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]Schedule, 0, len(m.schedules))
+	for _, s := range m.schedules {
+		out = append(out, s)
+	}
+	return out, nil
+}
@@ -0,0 +1,163 @@
+// Package vesting tracks linear unlock schedules for
+// RewardsSubmissionForAllCreated and RewardsSubmissionForAllEarnersCreated
+// submissions. Each submission promises Amount of Token to vest linearly
+// over [StartTimestamp, StartTimestamp+Duration], split across its
+// StrategiesAndMultipliers by weight. Neither event says which earner
+// ends up with what share of that pool - that's decided off-chain by
+// each earner's stake in the listed strategies at calculation time, the
+// same computation the Merkle distribution in chainio/rewards/merkle
+// ultimately commits to - so Ledger tracks vesting per (strategy, token)
+// rather than per earner, and a caller wanting an individual earner's cut
+// still needs that stake-weighting step once a root lands.
+package vesting
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// Key identifies one strategy's slice of one submission's vesting
+// schedule. It's stable under event replay, so re-ingesting the same log
+// twice overwrites the same entry instead of double-counting it.
+type Key struct {
+	RewardsSubmissionHash [32]byte
+	Strategy              common.Address
+	Token                 common.Address
+}
+
+// Schedule is one strategy's linearly-vesting slice of a submission.
+type Schedule struct {
+	Key            Key
+	Total          *big.Int
+	StartTimestamp uint32
+	Duration       uint32
+}
+
+// VestedAt returns how much of s has vested by atTimestamp.
+func (s Schedule) VestedAt(atTimestamp uint32) *big.Int {
+	if atTimestamp <= s.StartTimestamp || s.Duration == 0 {
+		return new(big.Int)
+	}
+	elapsed := atTimestamp - s.StartTimestamp
+	if elapsed >= s.Duration {
+		return new(big.Int).Set(s.Total)
+	}
+	vested := new(big.Int).Mul(s.Total, big.NewInt(int64(elapsed)))
+	return vested.Div(vested, big.NewInt(int64(s.Duration)))
+}
+
+// Ledger accumulates vesting Schedules decoded from submission events and
+// persists them to a KVStore so ingestion is idempotent across restarts
+// and replayed logs.
+type Ledger struct {
+	store KVStore
+}
+
+// New returns a Ledger persisting to store.
+func New(store KVStore) *Ledger {
+	return &Ledger{store: store}
+}
+
+// IngestRewardsSubmissionForAllCreated decodes ev's RewardsSubmission
+// into one Schedule per strategy, weighted by that strategy's multiplier
+// share of the total, and saves each to the KVStore.
+func (l *Ledger) IngestRewardsSubmissionForAllCreated(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated) error {
+	return l.ingest(ctx, ev.RewardsSubmissionHash, ev.RewardsSubmission)
+}
+
+// IngestRewardsSubmissionForAllEarnersCreated decodes ev's
+// RewardsSubmission the same way as IngestRewardsSubmissionForAllCreated.
+func (l *Ledger) IngestRewardsSubmissionForAllEarnersCreated(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated) error {
+	return l.ingest(ctx, ev.RewardsSubmissionHash, ev.RewardsSubmission)
+}
+
+func (l *Ledger) ingest(ctx context.Context, hash [32]byte, sub contractIRewardsCoordinator.IRewardsCoordinatorRewardsSubmission) error {
+	totalMultiplier := new(big.Int)
+	for _, sm := range sub.StrategiesAndMultipliers {
+		totalMultiplier.Add(totalMultiplier, sm.Multiplier)
+	}
+	if totalMultiplier.Sign() == 0 {
+		return fmt.Errorf("submission %x has zero total multiplier across %d strategies", hash, len(sub.StrategiesAndMultipliers))
+	}
+
+	for _, sm := range sub.StrategiesAndMultipliers {
+		share := new(big.Int).Mul(sub.Amount, sm.Multiplier)
+		share.Div(share, totalMultiplier)
+
+		schedule := Schedule{
+			Key:            Key{RewardsSubmissionHash: hash, Strategy: sm.Strategy, Token: sub.Token},
+			Total:          share,
+			StartTimestamp: sub.StartTimestamp,
+			Duration:       sub.Duration,
+		}
+		if err := l.store.Save(ctx, schedule.Key, schedule); err != nil {
+			return fmt.Errorf("error saving vesting schedule for submission %x strategy %s: %w", hash, sm.Strategy, err)
+		}
+	}
+	return nil
+}
+
+// VestedAmount returns how much of strategy's token pool has vested by
+// atTimestamp, summed across every submission that's funded it.
+func (l *Ledger) VestedAmount(ctx context.Context, strategy, token common.Address, atTimestamp uint32) (*big.Int, error) {
+	schedules, err := l.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing vesting schedules: %w", err)
+	}
+	vested := new(big.Int)
+	for _, s := range schedules {
+		if s.Key.Strategy != strategy || s.Key.Token != token {
+			continue
+		}
+		vested.Add(vested, s.VestedAt(atTimestamp))
+	}
+	return vested, nil
+}
+
+// ClaimableNow is VestedAmount evaluated at the current time.
+func (l *Ledger) ClaimableNow(ctx context.Context, strategy, token common.Address) (*big.Int, error) {
+	return l.VestedAmount(ctx, strategy, token, uint32(time.Now().Unix()))
+}
+
+// Unlock is one schedule's remaining unlock, due at Strategy/Token within
+// some UpcomingUnlocks window.
+type Unlock struct {
+	Key       Key
+	Remaining *big.Int
+	At        uint32
+}
+
+// UpcomingUnlocks returns every schedule funding strategy that finishes
+// vesting within window from now but hasn't fully vested yet, alongside
+// how much of it is still locked.
+func (l *Ledger) UpcomingUnlocks(ctx context.Context, strategy common.Address, window time.Duration) ([]Unlock, error) {
+	schedules, err := l.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing vesting schedules: %w", err)
+	}
+	now := uint32(time.Now().Unix())
+	deadline := uint32(time.Now().Add(window).Unix())
+
+	var unlocks []Unlock
+	for _, s := range schedules {
+		if s.Key.Strategy != strategy {
+			continue
+		}
+		fullyVestsAt := s.StartTimestamp + s.Duration
+		if fullyVestsAt <= now || fullyVestsAt > deadline {
+			continue
+		}
+		remaining := new(big.Int).Sub(s.Total, s.VestedAt(now))
+		if remaining.Sign() <= 0 {
+			continue
+		}
+		unlocks = append(unlocks, Unlock{Key: s.Key, Remaining: remaining, At: fullyVestsAt})
+	}
+	return unlocks, nil
+}
@@ -0,0 +1,50 @@
+package rewardsstream
+
+import (
+	"context"
+	"sync"
+)
+
+// CheckpointStore persists how far a Stream has delivered, so a process
+// that crashes and restarts resumes from there instead of losing track of
+// what it's already seen. Subscriber only resumes at block granularity,
+// so Stream re-backfills the checkpointed block in full, then uses
+// LogIndex itself to skip every event at or before it in that block
+// before the caller ever sees it.
+type CheckpointStore interface {
+	// SaveCheckpoint records that every non-reverted event up to and
+	// including (block, logIndex) has been delivered.
+	SaveCheckpoint(ctx context.Context, block uint64, logIndex uint) error
+	// LoadCheckpoint returns the last saved checkpoint, or ok=false if
+	// none has been saved yet.
+	LoadCheckpoint(ctx context.Context) (block uint64, logIndex uint, ok bool, err error)
+}
+
+// MemoryCheckpointStore is an in-process CheckpointStore with no
+// persistence across restarts, suitable for tests or short-lived runs.
+type MemoryCheckpointStore struct {
+	mu       sync.Mutex
+	block    uint64
+	logIndex uint
+	ok       bool
+}
+
+// NewMemoryCheckpointStore returns an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{}
+}
+
+// SaveCheckpoint implements CheckpointStore.
+func (m *MemoryCheckpointStore) SaveCheckpoint(ctx context.Context, block uint64, logIndex uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.block, m.logIndex, m.ok = block, logIndex, true
+	return nil
+}
+
+// LoadCheckpoint implements CheckpointStore.
+func (m *MemoryCheckpointStore) LoadCheckpoint(ctx context.Context) (uint64, uint, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.block, m.logIndex, m.ok, nil
+}
@@ -0,0 +1,177 @@
+// Package rewardsstream adds checkpointed resume to the
+// chainio/subscriber/rewardscoordinator Subscriber, so a long-running
+// indexer can persist how far it's gotten and pick back up there after a
+// restart instead of re-backfilling from genesis (or losing events that
+// landed while it was down). Subscriber already does the hard part -
+// multiplexing all fourteen RewardsCoordinator events into one ordered,
+// reorg-safe stream - this package just adds the checkpoint bookkeeping
+// around it.
+package rewardsstream
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// RewardsEvent is the tagged-union element Stream delivers: one of
+// fourteen RewardsCoordinator events, ordered by (BlockNumber, LogIndex)
+// and reorg-final, with Reverted set for a re-delivery of an event whose
+// block was since orphaned.
+type RewardsEvent = rewardscoordinator.RewardsCoordinatorEvent
+
+// EventKind identifies which event a RewardsEvent carries.
+type EventKind = rewardscoordinator.EventKind
+
+// eventFilterer is the subset of the RewardsCoordinator binding Stream
+// needs, identical to rewardscoordinator's own (unexported) eventFilterer
+// - redeclared here so Stream's constructor can name it without depending
+// on that package's internals.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+// Config controls a Stream's underlying Subscriber and checkpointing.
+type Config struct {
+	rewardscoordinator.Config
+	// Checkpoints persists how far Subscribe has delivered, so a restart
+	// resumes from there instead of the caller's requested fromBlock.
+	// Nil disables checkpointing.
+	Checkpoints CheckpointStore
+}
+
+// Stream wraps a rewardscoordinator.Subscriber with checkpointed resume.
+type Stream struct {
+	sub         *rewardscoordinator.Subscriber
+	checkpoints CheckpointStore
+	logger      logging.Logger
+}
+
+// New returns a Stream reading from filterer. headFunc resolves the
+// current chain head, passed straight through to the underlying
+// Subscriber.
+func New(filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), config Config, logger logging.Logger) *Stream {
+	return &Stream{
+		sub:         rewardscoordinator.New(filterer, headFunc, config.Config, logger),
+		checkpoints: config.Checkpoints,
+		logger:      logger,
+	}
+}
+
+// Subscribe starts delivering RewardsEvents from fromBlock, or from the
+// last saved checkpoint's block if that's ahead of fromBlock. As
+// non-reverted events are delivered, Subscribe saves a new checkpoint
+// past each one so a later Subscribe call resumes without gaps or
+// duplicates: Subscriber only resumes at block granularity, so the
+// checkpointed block is re-backfilled in full, but Subscribe skips every
+// event at or before the checkpointed log index within that one block
+// before forwarding to the caller.
+func (s *Stream) Subscribe(ctx context.Context, fromBlock uint64) (rewardscoordinator.Subscription, <-chan RewardsEvent, error) {
+	start, resumeLogIndex, err := s.resumeFrom(ctx, fromBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// resumeFrom reports log index 0 with or without a checkpoint, so only
+	// skip within start when a checkpoint actually names that block.
+	skipInStart := false
+	if s.checkpoints != nil {
+		if block, _, ok, err := s.checkpoints.LoadCheckpoint(ctx); err == nil && ok && block == start {
+			skipInStart = true
+		}
+	}
+
+	raw := make(chan RewardsEvent)
+	sub, err := s.sub.Subscribe(ctx, start, raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make(chan RewardsEvent)
+	go func() {
+		defer close(out)
+		for ev := range raw {
+			if skipInStart && ev.BlockNumber == start && ev.LogIndex <= resumeLogIndex {
+				continue
+			}
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+			if !ev.Reverted && s.checkpoints != nil {
+				if err := s.checkpoints.SaveCheckpoint(ctx, ev.BlockNumber, ev.LogIndex); err != nil && s.logger != nil {
+					s.logger.Error("error saving rewardsstream checkpoint", "block", ev.BlockNumber, "logIndex", ev.LogIndex, "err", err)
+				}
+			}
+		}
+	}()
+
+	return sub, out, nil
+}
+
+// resumeFrom returns the block Subscribe should resume backfill from and
+// the last log index within that block that was already delivered (0 if
+// resuming from fromBlock with no checkpoint ahead of it, in which case
+// the caller has no prior delivery in that block to skip).
+func (s *Stream) resumeFrom(ctx context.Context, fromBlock uint64) (uint64, uint, error) {
+	if s.checkpoints == nil {
+		return fromBlock, 0, nil
+	}
+	block, logIndex, ok, err := s.checkpoints.LoadCheckpoint(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error loading rewardsstream checkpoint: %w", err)
+	}
+	if !ok || block < fromBlock {
+		return fromBlock, 0, nil
+	}
+	return block, logIndex, nil
+}
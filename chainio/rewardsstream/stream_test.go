@@ -0,0 +1,73 @@
+package rewardsstream
+
+import (
+	"context"
+	"testing"
+)
+
+// fakeCheckpointStore is a fixed CheckpointStore fixture: LoadCheckpoint
+// always returns the block/logIndex/ok it was constructed with.
+type fakeCheckpointStore struct {
+	block    uint64
+	logIndex uint
+	ok       bool
+}
+
+func (f *fakeCheckpointStore) SaveCheckpoint(ctx context.Context, block uint64, logIndex uint) error {
+	return nil
+}
+
+func (f *fakeCheckpointStore) LoadCheckpoint(ctx context.Context) (uint64, uint, bool, error) {
+	return f.block, f.logIndex, f.ok, nil
+}
+
+// TestResumeFromReturnsCheckpointedBlockAndLogIndex pins resumeFrom's
+// contract: when a checkpoint exists at or past fromBlock, it resumes
+// from the checkpointed block itself (not block+1 - Subscriber only
+// resumes at block granularity) and reports the checkpointed log index so
+// Subscribe can skip already-delivered events within that one re-scanned
+// block. Losing track of the log index here is exactly what let a crash
+// mid-block replay earlier log indices as if they were new.
+func TestResumeFromReturnsCheckpointedBlockAndLogIndex(t *testing.T) {
+	s := &Stream{checkpoints: &fakeCheckpointStore{block: 100, logIndex: 1, ok: true}}
+
+	block, logIndex, err := s.resumeFrom(context.Background(), 50)
+	if err != nil {
+		t.Fatalf("resumeFrom: %v", err)
+	}
+	if block != 100 || logIndex != 1 {
+		t.Fatalf("resumeFrom(50) = (%d, %d), want (100, 1)", block, logIndex)
+	}
+}
+
+// TestResumeFromPrefersRequestedBlockWhenAhead pins the case where the
+// caller's fromBlock is already past the checkpoint (e.g. a narrower
+// replay): resumeFrom must not rewind to the stale checkpoint, and must
+// report a zero log index since there's no prior delivery in fromBlock to
+// skip.
+func TestResumeFromPrefersRequestedBlockWhenAhead(t *testing.T) {
+	s := &Stream{checkpoints: &fakeCheckpointStore{block: 100, logIndex: 5, ok: true}}
+
+	block, logIndex, err := s.resumeFrom(context.Background(), 150)
+	if err != nil {
+		t.Fatalf("resumeFrom: %v", err)
+	}
+	if block != 150 || logIndex != 0 {
+		t.Fatalf("resumeFrom(150) = (%d, %d), want (150, 0)", block, logIndex)
+	}
+}
+
+// TestResumeFromWithNoCheckpointUsesFromBlock pins the no-checkpoint-yet
+// path: resumeFrom must fall back to the caller's fromBlock with a zero
+// log index rather than erroring or resuming from block 0.
+func TestResumeFromWithNoCheckpointUsesFromBlock(t *testing.T) {
+	s := &Stream{checkpoints: &fakeCheckpointStore{ok: false}}
+
+	block, logIndex, err := s.resumeFrom(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("resumeFrom: %v", err)
+	}
+	if block != 42 || logIndex != 0 {
+		t.Fatalf("resumeFrom(42) = (%d, %d), want (42, 0)", block, logIndex)
+	}
+}
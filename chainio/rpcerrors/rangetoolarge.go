@@ -0,0 +1,35 @@
+// Package rpcerrors recognizes RPC provider error shapes that several
+// chainio packages need to react to, so each one doesn't carry its own
+// copy of the same substring matching.
+package rpcerrors
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrRangeTooLarge is a sentinel a caller can wrap its own error in (via
+// fmt.Errorf("...: %w", ErrRangeTooLarge)) to mark it as a range-too-large
+// condition without relying on IsRangeTooLarge's message matching.
+var ErrRangeTooLarge = errors.New("block range too large")
+
+// IsRangeTooLarge reports whether err looks like an RPC provider rejecting
+// a getLogs call for spanning too many blocks or results. Alchemy,
+// Infura, and QuickNode each phrase this differently, so this matches on
+// substrings rather than a single error code.
+func IsRangeTooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrRangeTooLarge) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "-32005") ||
+		strings.Contains(msg, "query returned more than") ||
+		strings.Contains(msg, "too many results") ||
+		strings.Contains(msg, "response size exceeded") ||
+		strings.Contains(msg, "exceed maximum block range") ||
+		(strings.Contains(msg, "block range") && strings.Contains(msg, "too large")) ||
+		(strings.Contains(msg, "is limited to") && strings.Contains(msg, "results"))
+}
@@ -0,0 +1,40 @@
+package rpcerrors
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestIsRangeTooLargeMatchesKnownProviderPhrasings pins IsRangeTooLarge
+// against the substrings Alchemy, Infura, QuickNode, and Ankr are each
+// known to use for a rejected block range, plus the shared sentinel a
+// caller can wrap instead of relying on message matching. If this drifts
+// out of sync with a provider's actual wording, callers relying on it to
+// halve a chunk size (rather than treat the error as fatal) silently stop
+// doing so.
+func TestIsRangeTooLargeMatchesKnownProviderPhrasings(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"infura code", fmt.Errorf("rpc error: code -32005 message: query returned more than 10000 results"), true},
+		{"quicknode phrasing", fmt.Errorf("eth_getLogs is limited to 10,000 results"), true},
+		{"alchemy phrasing", fmt.Errorf("block range too large"), true},
+		{"ankr phrasing", fmt.Errorf("response size exceeded"), true},
+		{"generic max range", fmt.Errorf("block range exceed maximum block range: 2000"), true},
+		{"wrapped sentinel", fmt.Errorf("fetching logs: %w", ErrRangeTooLarge), true},
+		{"unrelated error", fmt.Errorf("connection refused"), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := IsRangeTooLarge(c.err); got != c.want {
+				t.Fatalf("IsRangeTooLarge(%q) = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+
+	if IsRangeTooLarge(nil) {
+		t.Fatal("IsRangeTooLarge(nil) = true, want false")
+	}
+}
@@ -0,0 +1,180 @@
+// Package rewardscoordinator wraps every Filter*/Watch* pair generated
+// for the RewardsCoordinator contract - all fourteen of its events -
+// behind a single Subscribe call. Each generated Watch* returns a raw
+// event.Subscription that dies on the first transport blip and leaves the
+// FilterLogs/WatchLogs handoff and reorg bookkeeping to the caller;
+// Subscribe does all of that once, multiplexing every event kind into one
+// typed sum and holding each event until it's Confirmations blocks deep
+// before delivering it, so callers see exactly-once, in-order,
+// reorg-final events without touching go-ethereum primitives directly.
+package rewardscoordinator
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// EventKind identifies which of the contract's fourteen events a
+// RewardsCoordinatorEvent carries.
+type EventKind int
+
+const (
+	KindAVSRewardsSubmissionCreated EventKind = iota
+	KindActivationDelaySet
+	KindClaimerForSet
+	KindDefaultOperatorSplitBipsSet
+	KindDistributionRootDisabled
+	KindDistributionRootSubmitted
+	KindOperatorAVSSplitBipsSet
+	KindOperatorDirectedAVSRewardsSubmissionCreated
+	KindOperatorPISplitBipsSet
+	KindRewardsClaimed
+	KindRewardsForAllSubmitterSet
+	KindRewardsSubmissionForAllCreated
+	KindRewardsSubmissionForAllEarnersCreated
+	KindRewardsUpdaterSet
+)
+
+// String returns the event name, as used in log messages.
+func (k EventKind) String() string {
+	switch k {
+	case KindAVSRewardsSubmissionCreated:
+		return "AVSRewardsSubmissionCreated"
+	case KindActivationDelaySet:
+		return "ActivationDelaySet"
+	case KindClaimerForSet:
+		return "ClaimerForSet"
+	case KindDefaultOperatorSplitBipsSet:
+		return "DefaultOperatorSplitBipsSet"
+	case KindDistributionRootDisabled:
+		return "DistributionRootDisabled"
+	case KindDistributionRootSubmitted:
+		return "DistributionRootSubmitted"
+	case KindOperatorAVSSplitBipsSet:
+		return "OperatorAVSSplitBipsSet"
+	case KindOperatorDirectedAVSRewardsSubmissionCreated:
+		return "OperatorDirectedAVSRewardsSubmissionCreated"
+	case KindOperatorPISplitBipsSet:
+		return "OperatorPISplitBipsSet"
+	case KindRewardsClaimed:
+		return "RewardsClaimed"
+	case KindRewardsForAllSubmitterSet:
+		return "RewardsForAllSubmitterSet"
+	case KindRewardsSubmissionForAllCreated:
+		return "RewardsSubmissionForAllCreated"
+	case KindRewardsSubmissionForAllEarnersCreated:
+		return "RewardsSubmissionForAllEarnersCreated"
+	case KindRewardsUpdaterSet:
+		return "RewardsUpdaterSet"
+	default:
+		return "unknown"
+	}
+}
+
+// allKinds is iterated to start one backfill/watch pair per event.
+var allKinds = []EventKind{
+	KindAVSRewardsSubmissionCreated,
+	KindActivationDelaySet,
+	KindClaimerForSet,
+	KindDefaultOperatorSplitBipsSet,
+	KindDistributionRootDisabled,
+	KindDistributionRootSubmitted,
+	KindOperatorAVSSplitBipsSet,
+	KindOperatorDirectedAVSRewardsSubmissionCreated,
+	KindOperatorPISplitBipsSet,
+	KindRewardsClaimed,
+	KindRewardsForAllSubmitterSet,
+	KindRewardsSubmissionForAllCreated,
+	KindRewardsSubmissionForAllEarnersCreated,
+	KindRewardsUpdaterSet,
+}
+
+// RewardsCoordinatorEvent is the tagged-union element Subscribe delivers.
+// Exactly one of the typed fields matching Kind is non-nil. Reverted is
+// set when this event's block has since been reorged out of the
+// canonical chain - by the time a caller sees one with Reverted true, the
+// same (BlockHash, LogIndex) has already been delivered once with
+// Reverted false.
+type RewardsCoordinatorEvent struct {
+	Kind        EventKind
+	BlockNumber uint64
+	BlockHash   common.Hash
+	LogIndex    uint
+	Reverted    bool
+
+	AVSRewardsSubmissionCreated                 *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated
+	ActivationDelaySet                          *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet
+	ClaimerForSet                               *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet
+	DefaultOperatorSplitBipsSet                 *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet
+	DistributionRootDisabled                    *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled
+	DistributionRootSubmitted                   *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted
+	OperatorAVSSplitBipsSet                     *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet
+	OperatorDirectedAVSRewardsSubmissionCreated *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated
+	OperatorPISplitBipsSet                      *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet
+	RewardsClaimed                              *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed
+	RewardsForAllSubmitterSet                   *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet
+	RewardsSubmissionForAllCreated              *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated
+	RewardsSubmissionForAllEarnersCreated       *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated
+	RewardsUpdaterSet                           *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet
+}
+
+// logKey identifies a single log for dedup and reorg-matching purposes.
+type logKey struct {
+	blockHash common.Hash
+	logIndex  uint
+}
+
+func keyOf(ev RewardsCoordinatorEvent) logKey {
+	return logKey{blockHash: ev.BlockHash, logIndex: ev.LogIndex}
+}
+
+// eventFilterer is the subset of the RewardsCoordinator binding Subscribe
+// backfills and watches - every Filter*/Watch* pair the contract exposes.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
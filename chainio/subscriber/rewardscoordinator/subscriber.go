@@ -0,0 +1,803 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// Config controls a Subscriber's chunking, confirmation depth, and
+// reconnect behavior.
+type Config struct {
+	// Confirmations is how many blocks behind the current head an event
+	// must be before Subscribe delivers it, to absorb shallow reorgs
+	// before a caller ever sees the event.
+	Confirmations uint64
+	// ChunkSize is the initial number of blocks requested per getLogs
+	// call during backfill. It's halved automatically (down to
+	// MinChunkSize) whenever the RPC reports the range as too large.
+	ChunkSize uint64
+	// MinChunkSize bounds how far ChunkSize will shrink. A range still
+	// rejected at that size fails the backfill pass, which is logged and
+	// retried after a backoff.
+	MinChunkSize uint64
+	// HeadPollInterval is how often Subscribe checks the current head to
+	// release buffered events that have reached Confirmations.
+	HeadPollInterval time.Duration
+	// ReorgWindow is how many of the most recently delivered events are
+	// retained in order to detect and re-deliver ones reorged out after
+	// delivery.
+	ReorgWindow int
+}
+
+// Subscriber wraps the RewardsCoordinator binding's Filter*/Watch* pairs
+// for all fourteen of its events behind Subscribe.
+type Subscriber struct {
+	filterer eventFilterer
+	headFunc func(ctx context.Context) (uint64, error)
+	config   Config
+	logger   logging.Logger
+}
+
+// New returns a Subscriber reading from filterer. headFunc resolves the
+// current chain head, used to bound backfill and to decide when a
+// buffered event has reached Config.Confirmations.
+func New(filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), config Config, logger logging.Logger) *Subscriber {
+	if config.ChunkSize == 0 {
+		config.ChunkSize = 10_000
+	}
+	if config.MinChunkSize == 0 {
+		config.MinChunkSize = 100
+	}
+	if config.HeadPollInterval == 0 {
+		config.HeadPollInterval = 12 * time.Second
+	}
+	if config.ReorgWindow == 0 {
+		config.ReorgWindow = 256
+	}
+	return &Subscriber{filterer: filterer, headFunc: headFunc, config: config, logger: logger}
+}
+
+// Subscription lets a caller stop a Subscribe call's backfill and watch
+// goroutines and observe a fatal error, mirroring go-ethereum's
+// event.Subscription.
+type Subscription interface {
+	Unsubscribe()
+	Err() <-chan error
+}
+
+type subscription struct {
+	cancel context.CancelFunc
+	errCh  chan error
+}
+
+func (s *subscription) Unsubscribe()      { s.cancel() }
+func (s *subscription) Err() <-chan error { return s.errCh }
+
+// Subscribe backfills every one of the RewardsCoordinator's fourteen
+// events from fromBlock through Confirmations blocks behind the current
+// head, then watches for new ones until the returned Subscription is
+// unsubscribed or ctx is canceled. Each event is delivered to sink
+// exactly once, in block order, only once it's Confirmations blocks deep;
+// a delivered event whose block is later reorged out is re-delivered
+// with Reverted set. Every Watch* subscription reconnects on its own with
+// exponential backoff, replaying the gap since its last delivered block
+// via FilterLogs before resuming WatchLogs, so a transport blip never
+// loses an event.
+func (s *Subscriber) Subscribe(ctx context.Context, fromBlock uint64, sink chan<- RewardsCoordinatorEvent) (Subscription, error) {
+	if _, err := s.headFunc(ctx); err != nil {
+		return nil, fmt.Errorf("error reading chain head: %w", err)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	raw := make(chan RewardsCoordinatorEvent, 256)
+
+	var wg sync.WaitGroup
+	for _, kind := range allKinds {
+		kind := kind
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runKind(runCtx, kind, fromBlock, raw)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(raw)
+	}()
+
+	go s.bufferAndDeliver(runCtx, raw, sink)
+
+	errCh := make(chan error, 1)
+	go func() {
+		<-runCtx.Done()
+		if err := ctx.Err(); err != nil {
+			errCh <- err
+		}
+	}()
+
+	return &subscription{cancel: cancel, errCh: errCh}, nil
+}
+
+// runKind backfills kind from fromBlock through Confirmations blocks
+// behind the head, then watches it live, reconnecting indefinitely (with
+// exponential backoff, capped at watchMaxBackoff) whenever the head read,
+// backfill, or watch fails. It only returns once ctx is canceled.
+func (s *Subscriber) runKind(ctx context.Context, kind EventKind, fromBlock uint64, raw chan<- RewardsCoordinatorEvent) {
+	next := fromBlock
+	backoff := watchMinBackoff
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		head, err := s.headFunc(ctx)
+		if err != nil {
+			s.logger.Error("error reading chain head", "event", kind, "err", err)
+			if !sleep(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff)
+			continue
+		}
+		safe := uint64(0)
+		if head > s.config.Confirmations {
+			safe = head - s.config.Confirmations
+		}
+
+		if safe >= next {
+			if err := s.backfillKind(ctx, kind, next, safe, raw); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				s.logger.Error("error backfilling event", "event", kind, "err", err)
+				if !sleep(ctx, backoff) {
+					return
+				}
+				backoff = nextBackoff(backoff)
+				continue
+			}
+			next = safe + 1
+			backoff = watchMinBackoff
+		}
+
+		err = s.watchKind(ctx, kind, &next, raw)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		s.logger.Error("event watcher disconnected, reconnecting", "event", kind, "err", err, "backoff", backoff)
+		if !sleep(ctx, backoff) {
+			return
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// backfillKind paginates kind's history over [from, to], halving the
+// chunk size whenever the RPC rejects a range as too large.
+func (s *Subscriber) backfillKind(ctx context.Context, kind EventKind, from, to uint64, raw chan<- RewardsCoordinatorEvent) error {
+	name := kind.String()
+	window := s.config.ChunkSize
+	for from <= to {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := from + window - 1
+		if end > to {
+			end = to
+		}
+
+		evs, err := s.fetch(kind, &bind.FilterOpts{Start: from, End: &end, Context: ctx})
+		if err != nil {
+			if isRangeTooLargeError(err) {
+				if window <= s.config.MinChunkSize {
+					return fmt.Errorf("error backfilling %s blocks %d-%d: range still rejected at minimum chunk size %d: %w", name, from, end, s.config.MinChunkSize, err)
+				}
+				window /= 2
+				if window < s.config.MinChunkSize {
+					window = s.config.MinChunkSize
+				}
+				continue
+			}
+			return fmt.Errorf("error backfilling %s blocks %d-%d: %w", name, from, end, err)
+		}
+
+		for _, ev := range evs {
+			select {
+			case raw <- ev:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		from = end + 1
+	}
+	return nil
+}
+
+// bufferAndDeliver holds every event read from raw until its block has
+// Config.Confirmations confirmations, then releases them to sink in
+// block order. It remembers the most recently delivered ReorgWindow
+// events' (blockHash, logIndex) so that a Reverted copy of one of them -
+// arriving on raw after the original RPC reports it Removed - can still
+// be re-delivered to sink; a Reverted copy of an event still waiting in
+// the confirmation buffer is instead dropped outright, since it was never
+// delivered in the first place.
+func (s *Subscriber) bufferAndDeliver(ctx context.Context, raw <-chan RewardsCoordinatorEvent, sink chan<- RewardsCoordinatorEvent) {
+	ticker := time.NewTicker(s.config.HeadPollInterval)
+	defer ticker.Stop()
+
+	var pending []RewardsCoordinatorEvent
+	seenKeys := make(map[logKey]bool)
+	var seen []logKey
+	remember := func(key logKey) {
+		seenKeys[key] = true
+		seen = append(seen, key)
+		if len(seen) > s.config.ReorgWindow {
+			delete(seenKeys, seen[0])
+			seen = seen[1:]
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-raw:
+			if !ok {
+				return
+			}
+			if ev.Reverted {
+				s.handleReverted(ctx, ev, &pending, seenKeys, sink)
+				continue
+			}
+			pending = append(pending, ev)
+		case <-ticker.C:
+			head, err := s.headFunc(ctx)
+			if err != nil {
+				s.logger.Error("error reading chain head for confirmation check", "err", err)
+				continue
+			}
+			safe := uint64(0)
+			if head > s.config.Confirmations {
+				safe = head - s.config.Confirmations
+			}
+
+			sort.Slice(pending, func(i, j int) bool { return pending[i].BlockNumber < pending[j].BlockNumber })
+			var remaining []RewardsCoordinatorEvent
+			for _, ev := range pending {
+				if ev.BlockNumber > safe {
+					remaining = append(remaining, ev)
+					continue
+				}
+				remember(keyOf(ev))
+				select {
+				case sink <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+			pending = remaining
+		}
+	}
+}
+
+func (s *Subscriber) handleReverted(ctx context.Context, ev RewardsCoordinatorEvent, pending *[]RewardsCoordinatorEvent, seenKeys map[logKey]bool, sink chan<- RewardsCoordinatorEvent) {
+	key := keyOf(ev)
+	for i, p := range *pending {
+		if keyOf(p) == key {
+			*pending = append((*pending)[:i], (*pending)[i+1:]...)
+			return
+		}
+	}
+	if !seenKeys[key] {
+		s.logger.Warn("ignoring reorg notice for an event outside the reorg window", "event", ev.Kind, "block", ev.BlockNumber)
+		return
+	}
+	select {
+	case sink <- ev:
+	case <-ctx.Done():
+	}
+}
+
+func (s *Subscriber) fetch(kind EventKind, opts *bind.FilterOpts) ([]RewardsCoordinatorEvent, error) {
+	switch kind {
+	case KindAVSRewardsSubmissionCreated:
+		it, err := s.filterer.FilterAVSRewardsSubmissionCreated(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, AVSRewardsSubmissionCreated: it.Event})
+		}
+		return out, it.Error()
+	case KindActivationDelaySet:
+		it, err := s.filterer.FilterActivationDelaySet(opts)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, ActivationDelaySet: it.Event})
+		}
+		return out, it.Error()
+	case KindClaimerForSet:
+		it, err := s.filterer.FilterClaimerForSet(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, ClaimerForSet: it.Event})
+		}
+		return out, it.Error()
+	case KindDefaultOperatorSplitBipsSet:
+		it, err := s.filterer.FilterDefaultOperatorSplitBipsSet(opts)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, DefaultOperatorSplitBipsSet: it.Event})
+		}
+		return out, it.Error()
+	case KindDistributionRootDisabled:
+		it, err := s.filterer.FilterDistributionRootDisabled(opts, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, DistributionRootDisabled: it.Event})
+		}
+		return out, it.Error()
+	case KindDistributionRootSubmitted:
+		it, err := s.filterer.FilterDistributionRootSubmitted(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, DistributionRootSubmitted: it.Event})
+		}
+		return out, it.Error()
+	case KindOperatorAVSSplitBipsSet:
+		it, err := s.filterer.FilterOperatorAVSSplitBipsSet(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, OperatorAVSSplitBipsSet: it.Event})
+		}
+		return out, it.Error()
+	case KindOperatorDirectedAVSRewardsSubmissionCreated:
+		it, err := s.filterer.FilterOperatorDirectedAVSRewardsSubmissionCreated(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, OperatorDirectedAVSRewardsSubmissionCreated: it.Event})
+		}
+		return out, it.Error()
+	case KindOperatorPISplitBipsSet:
+		it, err := s.filterer.FilterOperatorPISplitBipsSet(opts, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, OperatorPISplitBipsSet: it.Event})
+		}
+		return out, it.Error()
+	case KindRewardsClaimed:
+		it, err := s.filterer.FilterRewardsClaimed(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, RewardsClaimed: it.Event})
+		}
+		return out, it.Error()
+	case KindRewardsForAllSubmitterSet:
+		it, err := s.filterer.FilterRewardsForAllSubmitterSet(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, RewardsForAllSubmitterSet: it.Event})
+		}
+		return out, it.Error()
+	case KindRewardsSubmissionForAllCreated:
+		it, err := s.filterer.FilterRewardsSubmissionForAllCreated(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, RewardsSubmissionForAllCreated: it.Event})
+		}
+		return out, it.Error()
+	case KindRewardsSubmissionForAllEarnersCreated:
+		it, err := s.filterer.FilterRewardsSubmissionForAllEarnersCreated(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, RewardsSubmissionForAllEarnersCreated: it.Event})
+		}
+		return out, it.Error()
+	case KindRewardsUpdaterSet:
+		it, err := s.filterer.FilterRewardsUpdaterSet(opts, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var out []RewardsCoordinatorEvent
+		for it.Next() {
+			out = append(out, RewardsCoordinatorEvent{Kind: kind, BlockNumber: it.Event.Raw.BlockNumber, BlockHash: it.Event.Raw.BlockHash, LogIndex: it.Event.Raw.Index, Reverted: it.Event.Raw.Removed, RewardsUpdaterSet: it.Event})
+		}
+		return out, it.Error()
+	default:
+		return nil, fmt.Errorf("unknown event kind %d", kind)
+	}
+}
+
+// watchKind subscribes to kind live, delivering every event to raw and
+// advancing *next past its block. It returns once ctx is canceled or the
+// subscription ends, whichever comes first.
+func (s *Subscriber) watchKind(ctx context.Context, kind EventKind, next *uint64, raw chan<- RewardsCoordinatorEvent) error {
+	watchOpts := &bind.WatchOpts{Context: ctx}
+	switch kind {
+	case KindAVSRewardsSubmissionCreated:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated)
+		sub, err := s.filterer.WatchAVSRewardsSubmissionCreated(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, AVSRewardsSubmissionCreated: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindActivationDelaySet:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet)
+		sub, err := s.filterer.WatchActivationDelaySet(watchOpts, sink)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, ActivationDelaySet: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindClaimerForSet:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet)
+		sub, err := s.filterer.WatchClaimerForSet(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, ClaimerForSet: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindDefaultOperatorSplitBipsSet:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet)
+		sub, err := s.filterer.WatchDefaultOperatorSplitBipsSet(watchOpts, sink)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, DefaultOperatorSplitBipsSet: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindDistributionRootDisabled:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled)
+		sub, err := s.filterer.WatchDistributionRootDisabled(watchOpts, sink, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, DistributionRootDisabled: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindDistributionRootSubmitted:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted)
+		sub, err := s.filterer.WatchDistributionRootSubmitted(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, DistributionRootSubmitted: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindOperatorAVSSplitBipsSet:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet)
+		sub, err := s.filterer.WatchOperatorAVSSplitBipsSet(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, OperatorAVSSplitBipsSet: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindOperatorDirectedAVSRewardsSubmissionCreated:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated)
+		sub, err := s.filterer.WatchOperatorDirectedAVSRewardsSubmissionCreated(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, OperatorDirectedAVSRewardsSubmissionCreated: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindOperatorPISplitBipsSet:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet)
+		sub, err := s.filterer.WatchOperatorPISplitBipsSet(watchOpts, sink, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, OperatorPISplitBipsSet: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindRewardsClaimed:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed)
+		sub, err := s.filterer.WatchRewardsClaimed(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, RewardsClaimed: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindRewardsForAllSubmitterSet:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet)
+		sub, err := s.filterer.WatchRewardsForAllSubmitterSet(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, RewardsForAllSubmitterSet: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindRewardsSubmissionForAllCreated:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated)
+		sub, err := s.filterer.WatchRewardsSubmissionForAllCreated(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, RewardsSubmissionForAllCreated: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindRewardsSubmissionForAllEarnersCreated:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated)
+		sub, err := s.filterer.WatchRewardsSubmissionForAllEarnersCreated(watchOpts, sink, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, RewardsSubmissionForAllEarnersCreated: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	case KindRewardsUpdaterSet:
+		sink := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet)
+		sub, err := s.filterer.WatchRewardsUpdaterSet(watchOpts, sink, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-sub.Err():
+				return err
+			case ev := <-sink:
+				*next = ev.Raw.BlockNumber + 1
+				if !deliver(ctx, raw, RewardsCoordinatorEvent{Kind: kind, BlockNumber: ev.Raw.BlockNumber, BlockHash: ev.Raw.BlockHash, LogIndex: ev.Raw.Index, Reverted: ev.Raw.Removed, RewardsUpdaterSet: ev}) {
+					return ctx.Err()
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("unknown event kind %d", kind)
+	}
+}
+
+// deliver sends ev on raw, returning false if ctx is canceled first.
+func deliver(ctx context.Context, raw chan<- RewardsCoordinatorEvent, ev RewardsCoordinatorEvent) bool {
+	select {
+	case raw <- ev:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// sleep waits for d or ctx cancellation, returning false in the latter
+// case.
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func nextBackoff(d time.Duration) time.Duration {
+	d *= 2
+	if d > watchMaxBackoff {
+		d = watchMaxBackoff
+	}
+	return d
+}
+
+// watchMinBackoff and watchMaxBackoff bound the delay runKind waits
+// before retrying after a head read, backfill, or Watch* subscription
+// fails.
+const (
+	watchMinBackoff = time.Second
+	watchMaxBackoff = time.Minute
+)
+
+// isRangeTooLargeError reports whether err looks like an RPC provider
+// rejecting a getLogs call for spanning too many blocks or results.
+func isRangeTooLargeError(err error) bool {
+	return rpcerrors.IsRangeTooLarge(err)
+}
@@ -0,0 +1,72 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// noopLogger discards everything; bufferAndDeliver only logs on a
+// headFunc error, which this test never exercises.
+type noopLogger struct{}
+
+func (noopLogger) Debug(msg string, keysAndValues ...interface{}) {}
+func (noopLogger) Info(msg string, keysAndValues ...interface{})  {}
+func (noopLogger) Warn(msg string, keysAndValues ...interface{})  {}
+func (noopLogger) Error(msg string, keysAndValues ...interface{}) {}
+
+// TestBufferAndDeliverHoldsUntilConfirmationsThenReordersByBlock pins the
+// core reorg-safety contract every package in this module that collapsed
+// onto Subscriber now depends on: an event isn't released to sink until
+// its block is Config.Confirmations behind head, and events released in
+// the same confirmation pass come out in ascending block order even if
+// they arrived out of order on raw.
+func TestBufferAndDeliverHoldsUntilConfirmationsThenReordersByBlock(t *testing.T) {
+	var head atomic.Uint64
+	head.Store(10)
+	s := &Subscriber{
+		headFunc: func(ctx context.Context) (uint64, error) { return head.Load(), nil },
+		config: Config{
+			Confirmations:    3,
+			HeadPollInterval: 5 * time.Millisecond,
+			ReorgWindow:      16,
+		},
+		logger: noopLogger{},
+	}
+
+	raw := make(chan RewardsCoordinatorEvent)
+	sink := make(chan RewardsCoordinatorEvent, 8)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.bufferAndDeliver(ctx, raw, sink)
+
+	// Blocks 8 and 9 are only 1-2 behind head=10, shy of Confirmations=3
+	// (safe = head-Confirmations = 7): neither must be released yet. Send
+	// block 9 before 8 to confirm delivery order tracks block number, not
+	// arrival order.
+	raw <- RewardsCoordinatorEvent{Kind: KindActivationDelaySet, BlockNumber: 9}
+	raw <- RewardsCoordinatorEvent{Kind: KindActivationDelaySet, BlockNumber: 8}
+
+	select {
+	case ev := <-sink:
+		t.Fatalf("delivered event for block %d before it reached Confirmations", ev.BlockNumber)
+	case <-time.After(30 * time.Millisecond):
+	}
+
+	// Advance head so both blocks 8 and 9 are now >= Confirmations deep.
+	head.Store(12)
+
+	var got []uint64
+	for i := 0; i < 2; i++ {
+		select {
+		case ev := <-sink:
+			got = append(got, ev.BlockNumber)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for buffered event %d", i)
+		}
+	}
+	if len(got) != 2 || got[0] != 8 || got[1] != 9 {
+		t.Fatalf("delivery order = %v, want [8 9]", got)
+	}
+}
@@ -0,0 +1,179 @@
+// Package rewards adapts chainio/subscriber/rewardscoordinator's
+// fourteen-event Subscriber down to the four RewardsCoordinator events
+// (DistributionRootSubmitted, DistributionRootDisabled,
+// DefaultOperatorSplitBipsSet, OperatorAVSSplitBipsSet) this package's
+// callers care about, as a narrower StreamEvent tagged union. Subscriber
+// already does the chunked backfill, confirmations-gated delivery and
+// reorg-safe Reverted re-delivery this package used to reimplement
+// itself.
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// eventFilterer is the full RewardsCoordinator binding surface, identical
+// to rewardscoordinator's own (unexported) eventFilterer - redeclared
+// here so New can name it without depending on that package's internals.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+// EventKind identifies which RewardsCoordinator event a StreamEvent
+// carries.
+type EventKind int
+
+const (
+	KindDistributionRootSubmitted EventKind = iota
+	KindDistributionRootDisabled
+	KindDefaultOperatorSplitBipsSet
+	KindOperatorAVSSplitBipsSet
+)
+
+// StreamEvent is the tagged-union element RewardsEventStream delivers.
+// Exactly one of the typed fields matching Kind is non-nil. Reverted is
+// set when this event's block is no longer part of the canonical chain;
+// consumers should retract whatever they previously recorded for it
+// rather than treat this as a new occurrence.
+type StreamEvent struct {
+	Kind        EventKind
+	BlockNumber uint64
+	BlockHash   common.Hash
+	Reverted    bool
+
+	DistributionRootSubmitted   *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted
+	DistributionRootDisabled    *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled
+	DefaultOperatorSplitBipsSet *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet
+	OperatorAVSSplitBipsSet     *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet
+}
+
+// Config controls a RewardsEventStream's underlying Subscriber.
+type Config struct {
+	rewardscoordinator.Config
+}
+
+// RewardsEventStream narrows a rewardscoordinator.Subscriber's fourteen-
+// event stream down to the four events this package's callers use.
+type RewardsEventStream struct {
+	sub *rewardscoordinator.Subscriber
+}
+
+// New returns a RewardsEventStream reading from filterer. headFunc
+// resolves the current chain head, passed straight through to the
+// underlying Subscriber.
+func New(filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), config Config, logger logging.Logger) *RewardsEventStream {
+	return &RewardsEventStream{sub: rewardscoordinator.New(filterer, headFunc, config.Config, logger)}
+}
+
+// Run backfills from fromBlock and then switches to a live subscription,
+// delivering every tracked event to the returned channel until ctx is
+// canceled (at which point the channel is closed).
+func (s *RewardsEventStream) Run(ctx context.Context, fromBlock uint64) (<-chan StreamEvent, error) {
+	raw := make(chan rewardscoordinator.RewardsCoordinatorEvent)
+	sub, err := s.sub.Subscribe(ctx, fromBlock, raw)
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to RewardsCoordinator events: %w", err)
+	}
+
+	out := make(chan StreamEvent, 256)
+	go func() {
+		defer close(out)
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-sub.Err():
+				if ok && err != nil {
+					return
+				}
+			case ev, ok := <-raw:
+				if !ok {
+					return
+				}
+				if mapped, ok := mapEvent(ev); ok {
+					select {
+					case out <- mapped:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// mapEvent translates a RewardsCoordinatorEvent into a StreamEvent, if
+// its Kind is one of the four this package tracks.
+func mapEvent(ev rewardscoordinator.RewardsCoordinatorEvent) (StreamEvent, bool) {
+	out := StreamEvent{BlockNumber: ev.BlockNumber, BlockHash: ev.BlockHash, Reverted: ev.Reverted}
+	switch ev.Kind {
+	case rewardscoordinator.KindDistributionRootSubmitted:
+		out.Kind = KindDistributionRootSubmitted
+		out.DistributionRootSubmitted = ev.DistributionRootSubmitted
+	case rewardscoordinator.KindDistributionRootDisabled:
+		out.Kind = KindDistributionRootDisabled
+		out.DistributionRootDisabled = ev.DistributionRootDisabled
+	case rewardscoordinator.KindDefaultOperatorSplitBipsSet:
+		out.Kind = KindDefaultOperatorSplitBipsSet
+		out.DefaultOperatorSplitBipsSet = ev.DefaultOperatorSplitBipsSet
+	case rewardscoordinator.KindOperatorAVSSplitBipsSet:
+		out.Kind = KindOperatorAVSSplitBipsSet
+		out.OperatorAVSSplitBipsSet = ev.OperatorAVSSplitBipsSet
+	default:
+		return StreamEvent{}, false
+	}
+	return out, true
+}
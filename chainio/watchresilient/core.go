@@ -0,0 +1,235 @@
+// Package watchresilient wraps a single RewardsCoordinator Watch* method
+// at a time (as opposed to chainio/subscriber/rewardscoordinator, which
+// multiplexes all fourteen behind one Subscribe call) so an existing
+// single-event call site - already holding a typed sink channel from one
+// generated WatchXxx, and the same indexed-topic filter args that method
+// accepts - can upgrade to a durable subscription by changing one
+// function call. That per-call filtering is exactly what
+// rewardscoordinator.Subscriber can't do (its fetch always passes nil
+// filter args to every Filter*/Watch* call), which is why
+// WatchXxxResilient isn't a thin wrapper around Subscriber the way
+// chainio/rewards/events.Indexer is: a caller narrowing on, say, a
+// specific earner would silently get every earner's events back.
+//
+// Each WatchXxxResilient remembers the last delivered block, reconnects
+// with exponential backoff plus jitter when the underlying subscription
+// dies, replays the gap via FilterXxx - chunked the same way Subscriber
+// chunks its own backfill, halving on a range-too-large response rather
+// than retrying the same oversized window forever - before resuming,
+// and deduplicates against a bounded set of recently seen (txHash,
+// logIndex) pairs so a replay never double-delivers a log the live
+// subscription also redelivers.
+package watchresilient
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+)
+
+const (
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+
+	backfillChunkSize    = 10_000
+	backfillMinChunkSize = 100
+)
+
+// dedupKey identifies a log for the recently-seen set.
+type dedupKey struct {
+	txHash   common.Hash
+	logIndex uint
+}
+
+// seenSet is a bounded, insertion-ordered set of dedupKeys: once full,
+// inserting a new key evicts the oldest.
+type seenSet struct {
+	limit int
+	set   map[dedupKey]bool
+	order []dedupKey
+}
+
+func newSeenSet(limit int) *seenSet {
+	if limit <= 0 {
+		limit = 4096
+	}
+	return &seenSet{limit: limit, set: make(map[dedupKey]bool)}
+}
+
+// seenOrAdd reports whether key has already been recorded; if not, it
+// records it and returns false.
+func (s *seenSet) seenOrAdd(key dedupKey) bool {
+	if s.set[key] {
+		return true
+	}
+	s.set[key] = true
+	s.order = append(s.order, key)
+	if len(s.order) > s.limit {
+		oldest := s.order[0]
+		s.order = s.order[1:]
+		delete(s.set, oldest)
+	}
+	return false
+}
+
+// cursor is a mutex-guarded "next block to process" shared between
+// runResilient's own loop and the goroutine subscribe spawns to pump its
+// live subscription, since both read and advance it as events are
+// backfilled or delivered live.
+type cursor struct {
+	mu    sync.Mutex
+	block uint64
+}
+
+func newCursor(block uint64) *cursor {
+	return &cursor{block: block}
+}
+
+func (c *cursor) get() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.block
+}
+
+// advance sets the cursor to block+1 if that's further along than its
+// current value, so an out-of-order update (e.g. a late backfill result
+// racing a live delivery) never moves it backwards.
+func (c *cursor) advance(block uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if block+1 > c.block {
+		c.block = block + 1
+	}
+}
+
+// deliver applies the recently-seen dedup check to raw and, if it hasn't
+// already been delivered, calls send and advances next past raw's block.
+// It's shared by every WatchXxxResilient's backfill loop and live pump,
+// each of which already has a decoded, type-specific event and only needs
+// this bookkeeping wired around its own send closure.
+func deliver(ctx context.Context, seen *seenSet, next *cursor, raw types.Log, send func() error) error {
+	key := dedupKey{txHash: raw.TxHash, logIndex: raw.Index}
+	if seen.seenOrAdd(key) {
+		return nil
+	}
+	if err := send(); err != nil {
+		return err
+	}
+	next.advance(raw.BlockNumber)
+	return nil
+}
+
+// backfillChunked pages [from, to] in windows starting at
+// backfillChunkSize, calling fetch for each window in turn. If fetch
+// reports a range-too-large error, the window is halved (down to a
+// floor of backfillMinChunkSize) and the same starting block is
+// retried, rather than surfacing the error back to runResilient, which
+// would otherwise just retry the identical oversized window forever.
+func backfillChunked(ctx context.Context, from, to uint64, fetch func(ctx context.Context, from, to uint64) error) error {
+	window := uint64(backfillChunkSize)
+	for from <= to {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := from + window - 1
+		if end > to {
+			end = to
+		}
+		if err := fetch(ctx, from, end); err != nil {
+			if rpcerrors.IsRangeTooLarge(err) && window > backfillMinChunkSize {
+				window /= 2
+				if window < backfillMinChunkSize {
+					window = backfillMinChunkSize
+				}
+				continue
+			}
+			return fmt.Errorf("error backfilling blocks %d-%d: %w", from, end, err)
+		}
+		from = end + 1
+	}
+	return nil
+}
+
+// runResilient drives the reconnect/backfill loop shared by every
+// WatchXxxResilient: it backfills [next, head] via backfill, then calls
+// subscribe to tail live events from next onward. If the subscription's
+// error channel fires (or subscribe itself fails), it backs off and
+// repeats - recomputing the backfill gap from next, which subscribe is
+// expected to advance via next.advance as it delivers events - for as
+// long as ctx is live.
+func runResilient(ctx context.Context, next *cursor, headFunc func(ctx context.Context) (uint64, error), subscribe func(ctx context.Context) (unsubscribe func(), errCh <-chan error, err error), backfill func(ctx context.Context, from, to uint64) error) error {
+	backoff := minBackoff
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		head, err := headFunc(ctx)
+		if err != nil {
+			if slept := sleep(ctx, backoff); slept != nil {
+				return slept
+			}
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		if from := next.get(); from <= head {
+			if err := backfill(ctx, from, head); err != nil {
+				if slept := sleep(ctx, backoff); slept != nil {
+					return slept
+				}
+				backoff = nextBackoff(backoff)
+				continue
+			}
+			next.advance(head)
+		}
+		backoff = minBackoff
+
+		unsubscribe, errCh, err := subscribe(ctx)
+		if err != nil {
+			if slept := sleep(ctx, backoff); slept != nil {
+				return slept
+			}
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			unsubscribe()
+			return ctx.Err()
+		case <-errCh:
+			unsubscribe()
+			if slept := sleep(ctx, backoff); slept != nil {
+				return slept
+			}
+			backoff = nextBackoff(backoff)
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func nextBackoff(current time.Duration) time.Duration {
+	next := current * 2
+	if next > maxBackoff {
+		next = maxBackoff
+	}
+	jitter := time.Duration(rand.Int63n(int64(next)/4 + 1))
+	return next + jitter
+}
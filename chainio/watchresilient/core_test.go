@@ -0,0 +1,81 @@
+package watchresilient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+)
+
+// TestBackfillChunkedHalvesWindowOnRangeTooLarge pins the halving
+// behavior this package's backfill relies on to make forward progress
+// against an RPC that rejects a chunk as too large, instead of retrying
+// the identical oversized window forever.
+func TestBackfillChunkedHalvesWindowOnRangeTooLarge(t *testing.T) {
+	var windows []uint64
+	rejectAbove := uint64(2_000)
+
+	fetch := func(ctx context.Context, from, to uint64) error {
+		windows = append(windows, to-from+1)
+		if to-from+1 > rejectAbove {
+			return rpcerrors.ErrRangeTooLarge
+		}
+		return nil
+	}
+
+	if err := backfillChunked(context.Background(), 1, backfillChunkSize, fetch); err != nil {
+		t.Fatalf("backfillChunked: %v", err)
+	}
+
+	if len(windows) == 0 || windows[0] != backfillChunkSize {
+		t.Fatalf("first attempted window = %v, want %d", windows, uint64(backfillChunkSize))
+	}
+	for i := 1; i < len(windows) && windows[i-1] > rejectAbove; i++ {
+		if windows[i] != windows[i-1]/2 {
+			t.Fatalf("window sequence = %v, want each rejected window to halve the next", windows)
+		}
+	}
+	last := windows[len(windows)-1]
+	if last > rejectAbove {
+		t.Fatalf("final window %d still exceeds the size the fetch accepts (%d)", last, rejectAbove)
+	}
+}
+
+// TestBackfillChunkedStopsHalvingAtFloor pins that the window never
+// shrinks below backfillMinChunkSize: once there, a persistent
+// range-too-large error must surface as an error instead of looping
+// forever.
+func TestBackfillChunkedStopsHalvingAtFloor(t *testing.T) {
+	err := backfillChunked(context.Background(), 1, backfillChunkSize, func(ctx context.Context, from, to uint64) error {
+		return rpcerrors.ErrRangeTooLarge
+	})
+	if err == nil {
+		t.Fatal("expected an error once the window can no longer shrink, got nil")
+	}
+	if !errors.Is(err, rpcerrors.ErrRangeTooLarge) {
+		t.Fatalf("error = %v, want it to wrap rpcerrors.ErrRangeTooLarge", err)
+	}
+}
+
+// TestSeenSetEvictsOldestPastLimit pins seenSet's bounded, FIFO-eviction
+// dedup contract: WatchXxxResilient relies on this to avoid unbounded
+// memory growth while still catching a live subscription redelivering a
+// log its own backfill already sent.
+func TestSeenSetEvictsOldestPastLimit(t *testing.T) {
+	s := newSeenSet(2)
+
+	if s.seenOrAdd(dedupKey{logIndex: 1}) {
+		t.Fatal("first insert of key 1 reported as already seen")
+	}
+	if !s.seenOrAdd(dedupKey{logIndex: 1}) {
+		t.Fatal("second insert of key 1 not reported as already seen")
+	}
+
+	s.seenOrAdd(dedupKey{logIndex: 2})
+	s.seenOrAdd(dedupKey{logIndex: 3}) // evicts key 1, the oldest
+
+	if s.seenOrAdd(dedupKey{logIndex: 1}) {
+		t.Fatal("key 1 still reported as seen after being evicted")
+	}
+}
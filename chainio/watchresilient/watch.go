@@ -0,0 +1,986 @@
+package watchresilient
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// HeadFunc resolves the current chain head, used to bound each
+// WatchXxxResilient's backfill and to decide when it's caught up enough
+// to subscribe live. Typically backed by ethclient.Client.BlockNumber.
+type HeadFunc func(ctx context.Context) (uint64, error)
+
+type rewardsUpdaterSetFilterer interface {
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+// WatchRewardsUpdaterSetResilient is WatchRewardsUpdaterSet with
+// reconnect, gap-fill, and dedup: it behaves like the generated method
+// except sink keeps receiving events across transport drops instead of
+// the subscription silently dying.
+func WatchRewardsUpdaterSetResilient(ctx context.Context, filterer rewardsUpdaterSetFilterer, headFunc HeadFunc, fromBlock uint64, oldRewardsUpdater, newRewardsUpdater []common.Address, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterRewardsUpdaterSet(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, oldRewardsUpdater, newRewardsUpdater)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet)
+		sub, err := filterer.WatchRewardsUpdaterSet(&bind.WatchOpts{Context: ctx}, raw, oldRewardsUpdater, newRewardsUpdater)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type distributionRootSubmittedFilterer interface {
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+}
+
+// WatchDistributionRootSubmittedResilient is WatchDistributionRootSubmitted with reconnect, gap-fill, and dedup.
+func WatchDistributionRootSubmittedResilient(ctx context.Context, filterer distributionRootSubmittedFilterer, headFunc HeadFunc, fromBlock uint64, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterDistributionRootSubmitted(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, rootIndex, root, rewardsCalculationEndTimestamp)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted)
+		sub, err := filterer.WatchDistributionRootSubmitted(&bind.WatchOpts{Context: ctx}, raw, rootIndex, root, rewardsCalculationEndTimestamp)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type distributionRootDisabledFilterer interface {
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+}
+
+// WatchDistributionRootDisabledResilient is WatchDistributionRootDisabled with reconnect, gap-fill, and dedup.
+func WatchDistributionRootDisabledResilient(ctx context.Context, filterer distributionRootDisabledFilterer, headFunc HeadFunc, fromBlock uint64, rootIndex []uint32, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterDistributionRootDisabled(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, rootIndex)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled)
+		sub, err := filterer.WatchDistributionRootDisabled(&bind.WatchOpts{Context: ctx}, raw, rootIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type rewardsClaimedFilterer interface {
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+}
+
+// WatchRewardsClaimedResilient is WatchRewardsClaimed with reconnect, gap-fill, and dedup.
+func WatchRewardsClaimedResilient(ctx context.Context, filterer rewardsClaimedFilterer, headFunc HeadFunc, fromBlock uint64, earner, claimer, recipient []common.Address, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterRewardsClaimed(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, earner, claimer, recipient)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed)
+		sub, err := filterer.WatchRewardsClaimed(&bind.WatchOpts{Context: ctx}, raw, earner, claimer, recipient)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type claimerForSetFilterer interface {
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+}
+
+// WatchClaimerForSetResilient is WatchClaimerForSet with reconnect, gap-fill, and dedup.
+func WatchClaimerForSetResilient(ctx context.Context, filterer claimerForSetFilterer, headFunc HeadFunc, fromBlock uint64, earner, oldClaimer, claimer []common.Address, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterClaimerForSet(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, earner, oldClaimer, claimer)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet)
+		sub, err := filterer.WatchClaimerForSet(&bind.WatchOpts{Context: ctx}, raw, earner, oldClaimer, claimer)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type activationDelaySetFilterer interface {
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+}
+
+// WatchActivationDelaySetResilient is WatchActivationDelaySet with reconnect, gap-fill, and dedup.
+func WatchActivationDelaySetResilient(ctx context.Context, filterer activationDelaySetFilterer, headFunc HeadFunc, fromBlock uint64, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterActivationDelaySet(&bind.FilterOpts{Start: from, End: &end, Context: ctx})
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet)
+		sub, err := filterer.WatchActivationDelaySet(&bind.WatchOpts{Context: ctx}, raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type defaultOperatorSplitBipsSetFilterer interface {
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+}
+
+// WatchDefaultOperatorSplitBipsSetResilient is WatchDefaultOperatorSplitBipsSet with reconnect, gap-fill, and dedup.
+func WatchDefaultOperatorSplitBipsSetResilient(ctx context.Context, filterer defaultOperatorSplitBipsSetFilterer, headFunc HeadFunc, fromBlock uint64, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterDefaultOperatorSplitBipsSet(&bind.FilterOpts{Start: from, End: &end, Context: ctx})
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet)
+		sub, err := filterer.WatchDefaultOperatorSplitBipsSet(&bind.WatchOpts{Context: ctx}, raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type operatorAVSSplitBipsSetFilterer interface {
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+}
+
+// WatchOperatorAVSSplitBipsSetResilient is WatchOperatorAVSSplitBipsSet with reconnect, gap-fill, and dedup.
+func WatchOperatorAVSSplitBipsSetResilient(ctx context.Context, filterer operatorAVSSplitBipsSetFilterer, headFunc HeadFunc, fromBlock uint64, caller, operator, avs []common.Address, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterOperatorAVSSplitBipsSet(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, caller, operator, avs)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet)
+		sub, err := filterer.WatchOperatorAVSSplitBipsSet(&bind.WatchOpts{Context: ctx}, raw, caller, operator, avs)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type operatorPISplitBipsSetFilterer interface {
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+}
+
+// WatchOperatorPISplitBipsSetResilient is WatchOperatorPISplitBipsSet with reconnect, gap-fill, and dedup.
+func WatchOperatorPISplitBipsSetResilient(ctx context.Context, filterer operatorPISplitBipsSetFilterer, headFunc HeadFunc, fromBlock uint64, caller, operator []common.Address, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterOperatorPISplitBipsSet(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, caller, operator)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet)
+		sub, err := filterer.WatchOperatorPISplitBipsSet(&bind.WatchOpts{Context: ctx}, raw, caller, operator)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type rewardsForAllSubmitterSetFilterer interface {
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+}
+
+// WatchRewardsForAllSubmitterSetResilient is WatchRewardsForAllSubmitterSet with reconnect, gap-fill, and dedup.
+func WatchRewardsForAllSubmitterSetResilient(ctx context.Context, filterer rewardsForAllSubmitterSetFilterer, headFunc HeadFunc, fromBlock uint64, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterRewardsForAllSubmitterSet(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, rewardsForAllSubmitter, oldValue, newValue)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet)
+		sub, err := filterer.WatchRewardsForAllSubmitterSet(&bind.WatchOpts{Context: ctx}, raw, rewardsForAllSubmitter, oldValue, newValue)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type rewardsSubmissionForAllCreatedFilterer interface {
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+}
+
+// WatchRewardsSubmissionForAllCreatedResilient is WatchRewardsSubmissionForAllCreated with reconnect, gap-fill, and dedup.
+func WatchRewardsSubmissionForAllCreatedResilient(ctx context.Context, filterer rewardsSubmissionForAllCreatedFilterer, headFunc HeadFunc, fromBlock uint64, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterRewardsSubmissionForAllCreated(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, submitter, submissionNonce, rewardsSubmissionHash)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated)
+		sub, err := filterer.WatchRewardsSubmissionForAllCreated(&bind.WatchOpts{Context: ctx}, raw, submitter, submissionNonce, rewardsSubmissionHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type rewardsSubmissionForAllEarnersCreatedFilterer interface {
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+}
+
+// WatchRewardsSubmissionForAllEarnersCreatedResilient is WatchRewardsSubmissionForAllEarnersCreated with reconnect, gap-fill, and dedup.
+func WatchRewardsSubmissionForAllEarnersCreatedResilient(ctx context.Context, filterer rewardsSubmissionForAllEarnersCreatedFilterer, headFunc HeadFunc, fromBlock uint64, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterRewardsSubmissionForAllEarnersCreated(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, tokenHopper, submissionNonce, rewardsSubmissionHash)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated)
+		sub, err := filterer.WatchRewardsSubmissionForAllEarnersCreated(&bind.WatchOpts{Context: ctx}, raw, tokenHopper, submissionNonce, rewardsSubmissionHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type operatorDirectedAVSRewardsSubmissionCreatedFilterer interface {
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+}
+
+// WatchOperatorDirectedAVSRewardsSubmissionCreatedResilient is WatchOperatorDirectedAVSRewardsSubmissionCreated with reconnect, gap-fill, and dedup.
+func WatchOperatorDirectedAVSRewardsSubmissionCreatedResilient(ctx context.Context, filterer operatorDirectedAVSRewardsSubmissionCreatedFilterer, headFunc HeadFunc, fromBlock uint64, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterOperatorDirectedAVSRewardsSubmissionCreated(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, caller, avs, operatorDirectedRewardsSubmissionHash)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated)
+		sub, err := filterer.WatchOperatorDirectedAVSRewardsSubmissionCreated(&bind.WatchOpts{Context: ctx}, raw, caller, avs, operatorDirectedRewardsSubmissionHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
+
+type avsRewardsSubmissionCreatedFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+}
+
+// WatchAVSRewardsSubmissionCreatedResilient is WatchAVSRewardsSubmissionCreated with reconnect, gap-fill, and dedup.
+func WatchAVSRewardsSubmissionCreatedResilient(ctx context.Context, filterer avsRewardsSubmissionCreatedFilterer, headFunc HeadFunc, fromBlock uint64, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated) error {
+	next := newCursor(fromBlock)
+	seen := newSeenSet(4096)
+
+	backfill := func(ctx context.Context, from, to uint64) error {
+		return backfillChunked(ctx, from, to, func(ctx context.Context, from, to uint64) error {
+			end := to
+			it, err := filterer.FilterAVSRewardsSubmissionCreated(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, avs, submissionNonce, rewardsSubmissionHash)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for it.Next() {
+				ev := it.Event
+				send := func() error {
+					select {
+					case sink <- ev:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				if err := deliver(ctx, seen, next, ev.Raw, send); err != nil {
+					return err
+				}
+			}
+			return it.Error()
+		})
+	}
+
+	subscribe := func(ctx context.Context) (func(), <-chan error, error) {
+		raw := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated)
+		sub, err := filterer.WatchAVSRewardsSubmissionCreated(&bind.WatchOpts{Context: ctx}, raw, avs, submissionNonce, rewardsSubmissionHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			for {
+				select {
+				case ev, ok := <-raw:
+					if !ok {
+						return
+					}
+					send := func() error {
+						select {
+						case sink <- ev:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					_ = deliver(ctx, seen, next, ev.Raw, send)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return sub.Unsubscribe, sub.Err(), nil
+	}
+
+	return runResilient(ctx, next, headFunc, subscribe, backfill)
+}
@@ -0,0 +1,404 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/rpcerrors"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// defaultBlockChunkSize is how many blocks a single FilterLogs backfill
+// call spans, five times the 10_000-block chunks the Subscriber and the
+// resilient watchers use. It's halved (down to minBlockChunkSize)
+// whenever the RPC rejects a chunk as too large, rather than retrying
+// the identical range forever.
+const (
+	defaultBlockChunkSize = 50_000
+	minBlockChunkSize     = 100
+)
+
+// subscribeMinBackoff and subscribeMaxBackoff bound the delay Subscribe
+// waits before re-establishing its Watch* subscriptions after one of them
+// ends.
+const (
+	subscribeMinBackoff = time.Second
+	subscribeMaxBackoff = time.Minute
+)
+
+// eventFilterer is the subset of the RewardsCoordinator binding needed to
+// backfill and watch the events this package indexes.
+type eventFilterer interface {
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner []common.Address, claimer []common.Address, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner []common.Address, claimer []common.Address, recipient []common.Address) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller []common.Address, operator []common.Address, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller []common.Address, operator []common.Address, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller []common.Address, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller []common.Address, operator []common.Address) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+}
+
+// splitCaller is the subset of read methods needed to backfill split
+// state for operators the collector doesn't yet have an event history
+// for, e.g. on a cold start after their split was last changed before
+// CollectorConfig.StartBlock.
+type splitCaller interface {
+	GetOperatorAVSSplit(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error)
+	GetOperatorPISplit(opts *bind.CallOpts, operator common.Address) (uint16, error)
+}
+
+// CollectorConfig customizes a Collector's backfill range and chunking.
+type CollectorConfig struct {
+	StartBlock     uint64
+	BlockChunkSize uint64
+}
+
+// Collector indexes RewardsCoordinator events into a Store: it backfills
+// historical logs in chunks from a resumable cursor, then watches for new
+// ones, normalizing both into the same split/claim/root records. It
+// doesn't wrap chainio/subscriber/rewardscoordinator.Subscriber the way
+// most of this module's RewardsCoordinator watchers do: Subscriber
+// retries every kind's subscription errors internally and only ever
+// surfaces ctx cancellation to the caller, but Store.IncrementReconnectCount
+// and Store.IncrementUnpackErrors - the subscription health gauges this
+// package exists to expose - need to observe every individual
+// reconnect and decode failure, which Subscriber's API has no way to
+// report.
+type Collector struct {
+	filterer eventFilterer
+	caller   splitCaller
+	store    Store
+	config   CollectorConfig
+	logger   logging.Logger
+}
+
+// NewCollector returns a Collector that indexes events from filterer into
+// store, using caller to backfill cold-start split state.
+func NewCollector(filterer eventFilterer, caller splitCaller, store Store, config CollectorConfig, logger logging.Logger) *Collector {
+	if config.BlockChunkSize == 0 {
+		config.BlockChunkSize = defaultBlockChunkSize
+	}
+	return &Collector{filterer: filterer, caller: caller, store: store, config: config, logger: logger}
+}
+
+// BackfillColdStartSplits reads the current on-chain split for each
+// (operator, avs) pair in avsPairs and each operator in piOperators
+// directly via GetOperatorAVSSplit/GetOperatorPISplit, so a freshly
+// started Collector reports correct gauges immediately instead of waiting
+// to observe the next change event.
+func (c *Collector) BackfillColdStartSplits(ctx context.Context, avsPairs [][2]common.Address, piOperators []common.Address) error {
+	for _, pair := range avsPairs {
+		bips, err := c.caller.GetOperatorAVSSplit(&bind.CallOpts{Context: ctx}, pair[0], pair[1])
+		if err != nil {
+			return fmt.Errorf("error reading AVS split for operator %s avs %s: %w", pair[0], pair[1], err)
+		}
+		if err := c.store.UpsertAVSSplit(ctx, pair[0], pair[1], bips, 0); err != nil {
+			return fmt.Errorf("error persisting AVS split for operator %s avs %s: %w", pair[0], pair[1], err)
+		}
+	}
+	for _, operator := range piOperators {
+		bips, err := c.caller.GetOperatorPISplit(&bind.CallOpts{Context: ctx}, operator)
+		if err != nil {
+			return fmt.Errorf("error reading PI split for operator %s: %w", operator, err)
+		}
+		if err := c.store.UpsertPISplit(ctx, operator, bips, 0); err != nil {
+			return fmt.Errorf("error persisting PI split for operator %s: %w", operator, err)
+		}
+	}
+	return nil
+}
+
+// Backfill processes every log from the Collector's resume cursor (or
+// CollectorConfig.StartBlock on first run) through toBlock, in
+// BlockChunkSize-sized ranges (halved, down to minBlockChunkSize,
+// whenever the RPC rejects a range as too large), saving the cursor
+// after each successful chunk so a restart resumes rather than
+// re-scanning from the beginning.
+func (c *Collector) Backfill(ctx context.Context, toBlock uint64) error {
+	from := c.config.StartBlock
+	if cursor, ok, err := c.store.Cursor(ctx); err != nil {
+		return fmt.Errorf("error reading cursor: %w", err)
+	} else if ok {
+		from = cursor + 1
+	}
+
+	chunkSize := c.config.BlockChunkSize
+	for start := from; start <= toBlock; {
+		end := start + chunkSize - 1
+		if end > toBlock {
+			end = toBlock
+		}
+		if err := c.backfillRange(ctx, start, end); err != nil {
+			if rpcerrors.IsRangeTooLarge(err) && chunkSize > minBlockChunkSize {
+				chunkSize /= 2
+				if chunkSize < minBlockChunkSize {
+					chunkSize = minBlockChunkSize
+				}
+				continue
+			}
+			return fmt.Errorf("error backfilling blocks %d-%d: %w", start, end, err)
+		}
+		if err := c.store.SaveCursor(ctx, end); err != nil {
+			return fmt.Errorf("error saving cursor at block %d: %w", end, err)
+		}
+		c.logger.Debug("Backfilled rewards metrics chunk", "from", start, "to", end)
+		start = end + 1
+	}
+	return nil
+}
+
+func (c *Collector) backfillRange(ctx context.Context, from, to uint64) error {
+	opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+
+	roots, err := c.filterer.FilterDistributionRootSubmitted(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering DistributionRootSubmitted: %w", err)
+	}
+	for roots.Next() {
+		if err := c.handleRoot(ctx, roots.Event); err != nil {
+			roots.Close()
+			return err
+		}
+	}
+	roots.Close()
+
+	claims, err := c.filterer.FilterRewardsClaimed(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering RewardsClaimed: %w", err)
+	}
+	for claims.Next() {
+		if err := c.handleClaim(ctx, claims.Event); err != nil {
+			claims.Close()
+			return err
+		}
+	}
+	claims.Close()
+
+	avsSplits, err := c.filterer.FilterOperatorAVSSplitBipsSet(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering OperatorAVSSplitBipsSet: %w", err)
+	}
+	for avsSplits.Next() {
+		if err := c.handleAVSSplit(ctx, avsSplits.Event); err != nil {
+			avsSplits.Close()
+			return err
+		}
+	}
+	avsSplits.Close()
+
+	piSplits, err := c.filterer.FilterOperatorPISplitBipsSet(opts, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering OperatorPISplitBipsSet: %w", err)
+	}
+	for piSplits.Next() {
+		if err := c.handlePISplit(ctx, piSplits.Event); err != nil {
+			piSplits.Close()
+			return err
+		}
+	}
+	piSplits.Close()
+
+	disabled, err := c.filterer.FilterDistributionRootDisabled(opts, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering DistributionRootDisabled: %w", err)
+	}
+	for disabled.Next() {
+		if err := c.handleRootDisabled(ctx, disabled.Event); err != nil {
+			disabled.Close()
+			return err
+		}
+	}
+	disabled.Close()
+
+	defaultSplits, err := c.filterer.FilterDefaultOperatorSplitBipsSet(opts)
+	if err != nil {
+		return fmt.Errorf("error filtering DefaultOperatorSplitBipsSet: %w", err)
+	}
+	for defaultSplits.Next() {
+		if err := c.handleDefaultSplit(ctx, defaultSplits.Event); err != nil {
+			defaultSplits.Close()
+			return err
+		}
+	}
+	defaultSplits.Close()
+
+	return nil
+}
+
+// Subscribe watches all six event types live, normalizing each into the
+// Store as it arrives, until ctx is canceled. If a subscription ends with
+// an error, Subscribe records it as an unpack error, waits with
+// exponential backoff (capped at subscribeMaxBackoff), records a
+// reconnect, and re-subscribes rather than returning.
+func (c *Collector) Subscribe(ctx context.Context) error {
+	backoff := subscribeMinBackoff
+	for {
+		err := c.runSubscription(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if incErr := c.store.IncrementUnpackErrors(ctx); incErr != nil {
+			c.logger.Error("error recording subscription error", "err", incErr)
+		}
+		c.logger.Error("rewards metrics subscription ended, reconnecting", "err", err, "backoff", backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if incErr := c.store.IncrementReconnectCount(ctx); incErr != nil {
+			c.logger.Error("error recording reconnect count", "err", incErr)
+		}
+		backoff *= 2
+		if backoff > subscribeMaxBackoff {
+			backoff = subscribeMaxBackoff
+		}
+	}
+}
+
+// runSubscription establishes all six Watch* subscriptions and processes
+// events from them until ctx is canceled or one of them ends.
+func (c *Collector) runSubscription(ctx context.Context) error {
+	roots := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted)
+	claims := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed)
+	avsSplits := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet)
+	piSplits := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet)
+	disabled := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled)
+	defaultSplits := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet)
+
+	watchOpts := &bind.WatchOpts{Context: ctx}
+	subRoots, err := c.filterer.WatchDistributionRootSubmitted(watchOpts, roots, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error watching DistributionRootSubmitted: %w", err)
+	}
+	defer subRoots.Unsubscribe()
+
+	subClaims, err := c.filterer.WatchRewardsClaimed(watchOpts, claims, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error watching RewardsClaimed: %w", err)
+	}
+	defer subClaims.Unsubscribe()
+
+	subAVS, err := c.filterer.WatchOperatorAVSSplitBipsSet(watchOpts, avsSplits, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error watching OperatorAVSSplitBipsSet: %w", err)
+	}
+	defer subAVS.Unsubscribe()
+
+	subPI, err := c.filterer.WatchOperatorPISplitBipsSet(watchOpts, piSplits, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error watching OperatorPISplitBipsSet: %w", err)
+	}
+	defer subPI.Unsubscribe()
+
+	subDisabled, err := c.filterer.WatchDistributionRootDisabled(watchOpts, disabled, nil)
+	if err != nil {
+		return fmt.Errorf("error watching DistributionRootDisabled: %w", err)
+	}
+	defer subDisabled.Unsubscribe()
+
+	subDefaultSplit, err := c.filterer.WatchDefaultOperatorSplitBipsSet(watchOpts, defaultSplits)
+	if err != nil {
+		return fmt.Errorf("error watching DefaultOperatorSplitBipsSet: %w", err)
+	}
+	defer subDefaultSplit.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-subRoots.Err():
+			return fmt.Errorf("DistributionRootSubmitted subscription error: %w", err)
+		case err := <-subClaims.Err():
+			return fmt.Errorf("RewardsClaimed subscription error: %w", err)
+		case err := <-subAVS.Err():
+			return fmt.Errorf("OperatorAVSSplitBipsSet subscription error: %w", err)
+		case err := <-subPI.Err():
+			return fmt.Errorf("OperatorPISplitBipsSet subscription error: %w", err)
+		case err := <-subDisabled.Err():
+			return fmt.Errorf("DistributionRootDisabled subscription error: %w", err)
+		case err := <-subDefaultSplit.Err():
+			return fmt.Errorf("DefaultOperatorSplitBipsSet subscription error: %w", err)
+		case ev := <-roots:
+			if err := c.handleRoot(ctx, ev); err != nil {
+				return err
+			}
+			c.recordBlockSeen(ctx, ev.Raw.BlockNumber)
+		case ev := <-claims:
+			if err := c.handleClaim(ctx, ev); err != nil {
+				return err
+			}
+			c.recordBlockSeen(ctx, ev.Raw.BlockNumber)
+		case ev := <-avsSplits:
+			if err := c.handleAVSSplit(ctx, ev); err != nil {
+				return err
+			}
+			c.recordBlockSeen(ctx, ev.Raw.BlockNumber)
+		case ev := <-piSplits:
+			if err := c.handlePISplit(ctx, ev); err != nil {
+				return err
+			}
+			c.recordBlockSeen(ctx, ev.Raw.BlockNumber)
+		case ev := <-disabled:
+			if err := c.handleRootDisabled(ctx, ev); err != nil {
+				return err
+			}
+			c.recordBlockSeen(ctx, ev.Raw.BlockNumber)
+		case ev := <-defaultSplits:
+			if err := c.handleDefaultSplit(ctx, ev); err != nil {
+				return err
+			}
+			c.recordBlockSeen(ctx, ev.Raw.BlockNumber)
+		}
+	}
+}
+
+func (c *Collector) recordBlockSeen(ctx context.Context, blockNumber uint64) {
+	if err := c.store.RecordBlockSeen(ctx, blockNumber); err != nil {
+		c.logger.Error("error recording last block seen", "block", blockNumber, "err", err)
+	}
+}
+
+func (c *Collector) handleRoot(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted) error {
+	if err := c.store.IncrementRootSubmitted(ctx, ev.RootIndex); err != nil {
+		return err
+	}
+	return c.store.RecordDistributionRoot(ctx, time.Now())
+}
+
+func (c *Collector) handleRootDisabled(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled) error {
+	return c.store.IncrementRootDisabled(ctx)
+}
+
+func (c *Collector) handleDefaultSplit(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) error {
+	return c.store.SetDefaultOperatorSplit(ctx, ev.NewDefaultOperatorSplitBips)
+}
+
+func (c *Collector) handleClaim(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed) error {
+	return c.store.AddClaimed(ctx, ev.Earner, ev.Token, ev.ClaimedAmount)
+}
+
+func (c *Collector) handleAVSSplit(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet) error {
+	return c.store.UpsertAVSSplit(ctx, ev.Operator, ev.Avs, ev.NewOperatorAVSSplitBips, ev.ActivatedAt)
+}
+
+func (c *Collector) handlePISplit(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet) error {
+	return c.store.UpsertPISplit(ctx, ev.Operator, ev.NewOperatorPISplitBips, ev.ActivatedAt)
+}
@@ -0,0 +1,184 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "eigensdk_rewards"
+
+// Exporter periodically refreshes a set of Prometheus gauges/counters from
+// a Store's current state. It doesn't itself watch chain events - pair it
+// with a Collector writing into the same Store.
+type Exporter struct {
+	store Store
+
+	avsSplitBips      *prometheus.GaugeVec
+	piSplitBips       *prometheus.GaugeVec
+	cumulativeClaimed *prometheus.GaugeVec
+	secondsSinceRoot  prometheus.Gauge
+
+	// rootSubmittedTotal, rootDisabledTotal, reconnectTotal and
+	// unpackErrorTotal are monotonically increasing counts, but are
+	// exposed as Gauges since Refresh sets them from the Store's running
+	// totals rather than incrementing them as events are observed.
+	rootSubmittedTotal *prometheus.GaugeVec
+	rootDisabledTotal  prometheus.Gauge
+	defaultSplitBips   prometheus.Gauge
+
+	lastBlockSeen    prometheus.Gauge
+	reconnectTotal   prometheus.Gauge
+	unpackErrorTotal prometheus.Gauge
+}
+
+// NewExporter registers the exporter's metrics against reg and returns an
+// Exporter reading from store.
+func NewExporter(reg prometheus.Registerer, store Store) (*Exporter, error) {
+	e := &Exporter{
+		store: store,
+		avsSplitBips: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "operator_avs_split_bips",
+			Help:      "Current operator AVS split, in bips, as last set by OperatorAVSSplitBipsSet.",
+		}, []string{"operator", "avs"}),
+		piSplitBips: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "operator_pi_split_bips",
+			Help:      "Current operator programmatic-incentive split, in bips, as last set by OperatorPISplitBipsSet.",
+		}, []string{"operator"}),
+		cumulativeClaimed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "cumulative_claimed",
+			Help:      "Cumulative amount claimed via ProcessClaim/ProcessClaims, per earner and token.",
+		}, []string{"earner", "token"}),
+		secondsSinceRoot: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "seconds_since_last_distribution_root",
+			Help:      "Seconds since the Collector last observed a DistributionRootSubmitted event.",
+		}),
+		rootSubmittedTotal: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "distribution_root_submitted_total",
+			Help:      "Total DistributionRootSubmitted events observed, per root index.",
+		}, []string{"root_index"}),
+		rootDisabledTotal: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "distribution_root_disabled_total",
+			Help:      "Total DistributionRootDisabled events observed.",
+		}),
+		defaultSplitBips: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "default_operator_split_bips",
+			Help:      "Current protocol-wide default operator split, in bips, as last set by DefaultOperatorSplitBipsSet.",
+		}),
+		lastBlockSeen: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "subscription_last_block_seen",
+			Help:      "Block number of the most recent event the Collector's live subscription has observed.",
+		}),
+		reconnectTotal: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "subscription_reconnect_total",
+			Help:      "Total number of times the Collector's live subscription has had to reconnect.",
+		}),
+		unpackErrorTotal: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "subscription_unpack_errors_total",
+			Help:      "Total number of errors reported by the Collector's live subscription channels.",
+		}),
+	}
+	collectors := []prometheus.Collector{
+		e.avsSplitBips, e.piSplitBips, e.cumulativeClaimed, e.secondsSinceRoot,
+		e.rootSubmittedTotal, e.rootDisabledTotal, e.defaultSplitBips,
+		e.lastBlockSeen, e.reconnectTotal, e.unpackErrorTotal,
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return nil, fmt.Errorf("error registering rewards metrics collector: %w", err)
+		}
+	}
+	return e, nil
+}
+
+// Refresh reads the Store's current state and sets every gauge/counter to
+// match. Call it on a timer, or after each Collector event, depending on
+// how fresh the metrics need to be.
+func (e *Exporter) Refresh(ctx context.Context) error {
+	avsSplits, err := e.store.AllAVSSplits(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading AVS splits: %w", err)
+	}
+	for pair, bips := range avsSplits {
+		e.avsSplitBips.WithLabelValues(pair[0].Hex(), pair[1].Hex()).Set(float64(bips))
+	}
+
+	piSplits, err := e.store.AllPISplits(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading PI splits: %w", err)
+	}
+	for operator, bips := range piSplits {
+		e.piSplitBips.WithLabelValues(operator.Hex()).Set(float64(bips))
+	}
+
+	claimed, err := e.store.AllClaimed(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading cumulative claimed amounts: %w", err)
+	}
+	for pair, amount := range claimed {
+		value, _ := new(big.Float).SetInt(amount).Float64()
+		e.cumulativeClaimed.WithLabelValues(pair[0].Hex(), pair[1].Hex()).Set(value)
+	}
+
+	lastRoot, ok, err := e.store.LastDistributionRootTime(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading last distribution root time: %w", err)
+	}
+	if ok {
+		e.secondsSinceRoot.Set(time.Since(lastRoot).Seconds())
+	}
+
+	rootCounts, err := e.store.RootSubmittedCounts(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading distribution root submitted counts: %w", err)
+	}
+	for rootIndex, count := range rootCounts {
+		e.rootSubmittedTotal.WithLabelValues(strconv.FormatUint(uint64(rootIndex), 10)).Set(float64(count))
+	}
+
+	rootDisabled, err := e.store.RootDisabledCount(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading distribution root disabled count: %w", err)
+	}
+	e.rootDisabledTotal.Set(float64(rootDisabled))
+
+	if bips, ok, err := e.store.DefaultOperatorSplit(ctx); err != nil {
+		return fmt.Errorf("error reading default operator split: %w", err)
+	} else if ok {
+		e.defaultSplitBips.Set(float64(bips))
+	}
+
+	if block, ok, err := e.store.LastBlockSeen(ctx); err != nil {
+		return fmt.Errorf("error reading last block seen: %w", err)
+	} else if ok {
+		e.lastBlockSeen.Set(float64(block))
+	}
+
+	reconnects, err := e.store.ReconnectCount(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading reconnect count: %w", err)
+	}
+	e.reconnectTotal.Set(float64(reconnects))
+
+	unpackErrors, err := e.store.UnpackErrorCount(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading unpack error count: %w", err)
+	}
+	e.unpackErrorTotal.Set(float64(unpackErrors))
+
+	return nil
+}
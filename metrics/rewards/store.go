@@ -0,0 +1,294 @@
+// Package rewards indexes RewardsCoordinator events into a normalized,
+// persisted view and exposes it as Prometheus metrics - per-operator/AVS
+// split bps, cumulative claimed earnings per (earner, token), and time
+// since the last posted distribution root - following the same
+// backfill-then-watch pattern used by thegraph-exporter and similar L2
+// staking indexers.
+package rewards
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// splitKey identifies one operator's split setting; AVS is the zero
+// address for a PI (programmatic incentive) split, which applies across
+// all AVSs.
+type splitKey struct {
+	operator common.Address
+	avs      common.Address
+}
+
+type claimKey struct {
+	earner common.Address
+	token  common.Address
+}
+
+// Store persists the normalized view a Collector builds from
+// RewardsCoordinator events, plus the resume cursor. It's deliberately
+// narrow so it can be backed by bbolt, sqlite, or - as MemoryStore does -
+// nothing more than a map, behind the same interface.
+type Store interface {
+	// Cursor returns the last block number fully processed, and false
+	// if none has been recorded yet.
+	Cursor(ctx context.Context) (uint64, bool, error)
+	SaveCursor(ctx context.Context, blockNumber uint64) error
+
+	UpsertAVSSplit(ctx context.Context, operator, avs common.Address, bips uint16, activatedAt uint32) error
+	UpsertPISplit(ctx context.Context, operator common.Address, bips uint16, activatedAt uint32) error
+	AVSSplit(ctx context.Context, operator, avs common.Address) (bips uint16, ok bool, err error)
+	PISplit(ctx context.Context, operator common.Address) (bips uint16, ok bool, err error)
+	AllAVSSplits(ctx context.Context) (map[[2]common.Address]uint16, error)
+	AllPISplits(ctx context.Context) (map[common.Address]uint16, error)
+
+	AddClaimed(ctx context.Context, earner, token common.Address, amount *big.Int) error
+	AllClaimed(ctx context.Context) (map[[2]common.Address]*big.Int, error)
+
+	RecordDistributionRoot(ctx context.Context, observedAt time.Time) error
+	LastDistributionRootTime(ctx context.Context) (time.Time, bool, error)
+
+	IncrementRootSubmitted(ctx context.Context, rootIndex uint32) error
+	RootSubmittedCounts(ctx context.Context) (map[uint32]uint64, error)
+	IncrementRootDisabled(ctx context.Context) error
+	RootDisabledCount(ctx context.Context) (uint64, error)
+
+	SetDefaultOperatorSplit(ctx context.Context, bips uint16) error
+	DefaultOperatorSplit(ctx context.Context) (bips uint16, ok bool, err error)
+
+	// RecordBlockSeen, IncrementReconnectCount and IncrementUnpackErrors
+	// feed the Subscribe loop's health gauges: how current the live feed
+	// is, and how often it's had to recover.
+	RecordBlockSeen(ctx context.Context, blockNumber uint64) error
+	LastBlockSeen(ctx context.Context) (uint64, bool, error)
+	IncrementReconnectCount(ctx context.Context) error
+	ReconnectCount(ctx context.Context) (uint64, error)
+	IncrementUnpackErrors(ctx context.Context) error
+	UnpackErrorCount(ctx context.Context) (uint64, error)
+}
+
+// MemoryStore is an in-memory Store, suitable for short-lived processes or
+// tests; anything that needs to survive a restart should implement Store
+// against bbolt or sqlite instead.
+type MemoryStore struct {
+	mu sync.Mutex
+
+	cursor    uint64
+	hasCursor bool
+
+	avsSplits map[splitKey]uint16
+	piSplits  map[common.Address]uint16
+	claimed   map[claimKey]*big.Int
+
+	lastRoot    time.Time
+	hasLastRoot bool
+
+	rootSubmitted     map[uint32]uint64
+	rootDisabledCount uint64
+
+	defaultSplitBips uint16
+	hasDefaultSplit  bool
+	lastBlockSeen    uint64
+	hasLastBlockSeen bool
+	reconnectCount   uint64
+	unpackErrorCount uint64
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		avsSplits:     make(map[splitKey]uint16),
+		piSplits:      make(map[common.Address]uint16),
+		claimed:       make(map[claimKey]*big.Int),
+		rootSubmitted: make(map[uint32]uint64),
+	}
+}
+
+func (s *MemoryStore) Cursor(ctx context.Context) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursor, s.hasCursor, nil
+}
+
+func (s *MemoryStore) SaveCursor(ctx context.Context, blockNumber uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursor = blockNumber
+	s.hasCursor = true
+	return nil
+}
+
+func (s *MemoryStore) UpsertAVSSplit(ctx context.Context, operator, avs common.Address, bips uint16, activatedAt uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.avsSplits[splitKey{operator: operator, avs: avs}] = bips
+	return nil
+}
+
+func (s *MemoryStore) UpsertPISplit(ctx context.Context, operator common.Address, bips uint16, activatedAt uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.piSplits[operator] = bips
+	return nil
+}
+
+func (s *MemoryStore) AVSSplit(ctx context.Context, operator, avs common.Address) (uint16, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bips, ok := s.avsSplits[splitKey{operator: operator, avs: avs}]
+	return bips, ok, nil
+}
+
+func (s *MemoryStore) PISplit(ctx context.Context, operator common.Address) (uint16, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bips, ok := s.piSplits[operator]
+	return bips, ok, nil
+}
+
+func (s *MemoryStore) AllAVSSplits(ctx context.Context) (map[[2]common.Address]uint16, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[[2]common.Address]uint16, len(s.avsSplits))
+	for k, v := range s.avsSplits {
+		out[[2]common.Address{k.operator, k.avs}] = v
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) AllPISplits(ctx context.Context) (map[common.Address]uint16, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[common.Address]uint16, len(s.piSplits))
+	for k, v := range s.piSplits {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) AddClaimed(ctx context.Context, earner, token common.Address, amount *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := claimKey{earner: earner, token: token}
+	total, ok := s.claimed[key]
+	if !ok {
+		total = new(big.Int)
+		s.claimed[key] = total
+	}
+	total.Add(total, amount)
+	return nil
+}
+
+func (s *MemoryStore) AllClaimed(ctx context.Context) (map[[2]common.Address]*big.Int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[[2]common.Address]*big.Int, len(s.claimed))
+	for k, v := range s.claimed {
+		out[[2]common.Address{k.earner, k.token}] = new(big.Int).Set(v)
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) RecordDistributionRoot(ctx context.Context, observedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastRoot = observedAt
+	s.hasLastRoot = true
+	return nil
+}
+
+func (s *MemoryStore) LastDistributionRootTime(ctx context.Context) (time.Time, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastRoot, s.hasLastRoot, nil
+}
+
+func (s *MemoryStore) IncrementRootSubmitted(ctx context.Context, rootIndex uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rootSubmitted[rootIndex]++
+	return nil
+}
+
+func (s *MemoryStore) RootSubmittedCounts(ctx context.Context) (map[uint32]uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[uint32]uint64, len(s.rootSubmitted))
+	for k, v := range s.rootSubmitted {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) IncrementRootDisabled(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rootDisabledCount++
+	return nil
+}
+
+func (s *MemoryStore) RootDisabledCount(ctx context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rootDisabledCount, nil
+}
+
+func (s *MemoryStore) SetDefaultOperatorSplit(ctx context.Context, bips uint16) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.defaultSplitBips = bips
+	s.hasDefaultSplit = true
+	return nil
+}
+
+func (s *MemoryStore) DefaultOperatorSplit(ctx context.Context) (uint16, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.defaultSplitBips, s.hasDefaultSplit, nil
+}
+
+func (s *MemoryStore) RecordBlockSeen(ctx context.Context, blockNumber uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.hasLastBlockSeen && blockNumber < s.lastBlockSeen {
+		return nil
+	}
+	s.lastBlockSeen = blockNumber
+	s.hasLastBlockSeen = true
+	return nil
+}
+
+func (s *MemoryStore) LastBlockSeen(ctx context.Context) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastBlockSeen, s.hasLastBlockSeen, nil
+}
+
+func (s *MemoryStore) IncrementReconnectCount(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reconnectCount++
+	return nil
+}
+
+func (s *MemoryStore) ReconnectCount(ctx context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reconnectCount, nil
+}
+
+func (s *MemoryStore) IncrementUnpackErrors(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unpackErrorCount++
+	return nil
+}
+
+func (s *MemoryStore) UnpackErrorCount(ctx context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.unpackErrorCount, nil
+}
@@ -0,0 +1,159 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+const alertMetricsNamespace = "eigensdk_rewards"
+
+// AddressFilter gates which addresses AlertExporter fires callbacks for.
+// Metrics are always updated regardless of this filter; it only governs
+// the Go callbacks, so operators can scrape every address's metrics while
+// only paging on the ones they've listed. A zero-value AddressFilter lets
+// every address through.
+type AddressFilter struct {
+	// Allow, if non-empty, restricts callbacks to only these addresses.
+	Allow []common.Address
+	// Deny always suppresses callbacks for these addresses, even if they
+	// also appear in Allow.
+	Deny []common.Address
+}
+
+func (f AddressFilter) allows(addr common.Address) bool {
+	for _, d := range f.Deny {
+		if d == addr {
+			return false
+		}
+	}
+	if len(f.Allow) == 0 {
+		return true
+	}
+	for _, a := range f.Allow {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// RewardsSubmissionAlert normalizes RewardsSubmissionForAllCreated and
+// RewardsSubmissionForAllEarnersCreated into a single shape for
+// AlertExporter.OnRewardsSubmission, since the two events differ only in
+// which address field names the submitting party (Submitter vs
+// TokenHopper). Source reports which of the two triggered the callback.
+type RewardsSubmissionAlert struct {
+	Source                string
+	Submitter             common.Address
+	SubmissionNonce       *big.Int
+	RewardsSubmissionHash [32]byte
+	RewardsSubmission     contractIRewardsCoordinator.IRewardsCoordinatorRewardsSubmission
+}
+
+// AlertExporter watches the RewardsCoordinator's governance-sensitive
+// setters and reward-pool submissions - the events operators want paged
+// on immediately rather than discovered on the next dashboard check - and
+// both exports them as Prometheus metrics and fires user-supplied Go
+// callbacks with the parsed event. Unlike Exporter, which is a passive
+// scrape target, AlertExporter is meant to be wired directly into an
+// alerting pipeline (PagerDuty, Slack, etc.) via its callback fields.
+//
+// Like Exporter, it seeds metric state via its own FilterLogs pass up
+// through the current head, then hands live watching off to a
+// rewardscoordinator.Subscriber, which watches every kind while Run only
+// acts on the four this exporter tracks - the same eventFilterer and split between seeding and watching that
+// Exporter uses, and for the same reason: Subscriber can't tell a caller
+// "this delivery is backfill," which AlertExporter needs so a restart
+// doesn't re-page operators on governance changes it's already alerted
+// on.
+type AlertExporter struct {
+	filterer   eventFilterer
+	headFunc   func(ctx context.Context) (uint64, error)
+	sub        *rewardscoordinator.Subscriber
+	logger     logging.Logger
+	startBlock uint64
+	filter     AddressFilter
+
+	// OnRewardsUpdaterChanged, if set, is called for every
+	// RewardsUpdaterSet seen while watching live (not while backfilling),
+	// provided NewRewardsUpdater passes the configured AddressFilter.
+	OnRewardsUpdaterChanged func(*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet)
+	// OnSubmitterFlagChanged, if set, is called for every
+	// RewardsForAllSubmitterSet seen while watching live, provided
+	// RewardsForAllSubmitter passes the configured AddressFilter.
+	OnSubmitterFlagChanged func(*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet)
+	// OnRewardsSubmission, if set, is called for every
+	// RewardsSubmissionForAllCreated and RewardsSubmissionForAllEarnersCreated
+	// seen while watching live, provided the submitting address passes the
+	// configured AddressFilter.
+	OnRewardsSubmission func(RewardsSubmissionAlert)
+
+	updaterChangesTotal     prometheus.Counter
+	submitterEnabled        *prometheus.GaugeVec
+	submissionsCreatedTotal *prometheus.CounterVec
+	submissionEarnersAmount *prometheus.CounterVec
+}
+
+// AlertExporterConfig customizes an AlertExporter's backfill range,
+// underlying Subscriber, and callback filtering.
+type AlertExporterConfig struct {
+	// StartBlock is where FilterLogs-based seeding begins.
+	StartBlock uint64
+	Subscriber rewardscoordinator.Config
+	// Filter restricts which addresses OnRewardsUpdaterChanged,
+	// OnSubmitterFlagChanged, and OnRewardsSubmission fire for.
+	Filter AddressFilter
+}
+
+// NewAlertExporter registers the exporter's metrics against reg and
+// returns an AlertExporter that reads from filterer. headFunc resolves the
+// current chain head, used both to bound seeding and to start the
+// underlying Subscriber once seeding completes. Callbacks are left nil and
+// can be set on the returned value before calling Run.
+func NewAlertExporter(reg prometheus.Registerer, filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), config AlertExporterConfig, logger logging.Logger) (*AlertExporter, error) {
+	e := &AlertExporter{
+		filterer:   filterer,
+		headFunc:   headFunc,
+		sub:        rewardscoordinator.New(filterer, headFunc, config.Subscriber, logger),
+		logger:     logger,
+		startBlock: config.StartBlock,
+		filter:     config.Filter,
+		updaterChangesTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: alertMetricsNamespace,
+			Name:      "updater_changes_total",
+			Help:      "Total RewardsUpdaterSet events observed.",
+		}),
+		submitterEnabled: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: alertMetricsNamespace,
+			Name:      "for_all_submitter_enabled",
+			Help:      "Whether addr is currently allowed to submit RewardsSubmissionForAll(Earners), as last set by RewardsForAllSubmitterSet.",
+		}, []string{"addr"}),
+		submissionsCreatedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: alertMetricsNamespace,
+			Name:      "submission_for_all_created_total",
+			Help:      "Total RewardsSubmissionForAllCreated events observed, per submitter.",
+		}, []string{"submitter"}),
+		submissionEarnersAmount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: alertMetricsNamespace,
+			Name:      "submission_for_all_earners_amount",
+			Help:      "Cumulative amount submitted via RewardsSubmissionForAllEarnersCreated, per token.",
+		}, []string{"token"}),
+	}
+
+	collectors := []prometheus.Collector{
+		e.updaterChangesTotal, e.submitterEnabled, e.submissionsCreatedTotal, e.submissionEarnersAmount,
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
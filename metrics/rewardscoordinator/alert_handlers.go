@@ -0,0 +1,60 @@
+package rewardscoordinator
+
+import (
+	"math/big"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// handleRewardsUpdaterSet updates metric state for ev and, if alert is
+// true and NewRewardsUpdater passes the configured AddressFilter, fires
+// OnRewardsUpdaterChanged. alert is false while backfilling history, so
+// restarts don't page on events that have already been handled.
+func (e *AlertExporter) handleRewardsUpdaterSet(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, alert bool) {
+	e.updaterChangesTotal.Inc()
+	if !alert || e.OnRewardsUpdaterChanged == nil || !e.filter.allows(ev.NewRewardsUpdater) {
+		return
+	}
+	e.OnRewardsUpdaterChanged(ev)
+}
+
+func (e *AlertExporter) handleSubmitterFlagSet(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, alert bool) {
+	value := 0.0
+	if ev.NewValue {
+		value = 1.0
+	}
+	e.submitterEnabled.WithLabelValues(ev.RewardsForAllSubmitter.Hex()).Set(value)
+	if !alert || e.OnSubmitterFlagChanged == nil || !e.filter.allows(ev.RewardsForAllSubmitter) {
+		return
+	}
+	e.OnSubmitterFlagChanged(ev)
+}
+
+func (e *AlertExporter) handleSubmissionForAllCreated(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, alert bool) {
+	e.submissionsCreatedTotal.WithLabelValues(ev.Submitter.Hex()).Inc()
+	if !alert || e.OnRewardsSubmission == nil || !e.filter.allows(ev.Submitter) {
+		return
+	}
+	e.OnRewardsSubmission(RewardsSubmissionAlert{
+		Source:                "RewardsSubmissionForAllCreated",
+		Submitter:             ev.Submitter,
+		SubmissionNonce:       ev.SubmissionNonce,
+		RewardsSubmissionHash: ev.RewardsSubmissionHash,
+		RewardsSubmission:     ev.RewardsSubmission,
+	})
+}
+
+func (e *AlertExporter) handleSubmissionForAllEarnersCreated(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, alert bool) {
+	amount, _ := new(big.Float).SetInt(ev.RewardsSubmission.Amount).Float64()
+	e.submissionEarnersAmount.WithLabelValues(ev.RewardsSubmission.Token.Hex()).Add(amount)
+	if !alert || e.OnRewardsSubmission == nil || !e.filter.allows(ev.TokenHopper) {
+		return
+	}
+	e.OnRewardsSubmission(RewardsSubmissionAlert{
+		Source:                "RewardsSubmissionForAllEarnersCreated",
+		Submitter:             ev.TokenHopper,
+		SubmissionNonce:       ev.SubmissionNonce,
+		RewardsSubmissionHash: ev.RewardsSubmissionHash,
+		RewardsSubmission:     ev.RewardsSubmission,
+	})
+}
@@ -0,0 +1,66 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"fmt"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+)
+
+// Run seeds alert metric state from history through the current head,
+// then watches the four kinds this exporter tracks - via its underlying
+// Subscriber - until ctx is canceled. As with Exporter.Run, Reverted
+// deliveries are dropped rather than undone: a reorg shallow enough to
+// flip one of these events is rare enough not to warrant unwinding
+// metric state or re-firing callbacks for it.
+func (e *AlertExporter) Run(ctx context.Context) error {
+	head, err := e.headFunc(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading chain head: %w", err)
+	}
+	if err := e.seed(ctx, head); err != nil {
+		return fmt.Errorf("error seeding rewardscoordinator alert metrics: %w", err)
+	}
+
+	raw := make(chan rewardscoordinator.RewardsCoordinatorEvent)
+	sub, err := e.sub.Subscribe(ctx, head+1, raw)
+	if err != nil {
+		return fmt.Errorf("error subscribing to RewardsCoordinator events: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-sub.Err():
+			if ok && err != nil {
+				e.logger.Error("error in RewardsCoordinator alert subscription", "err", err)
+			}
+			return nil
+		case ev, ok := <-raw:
+			if !ok {
+				return nil
+			}
+			if ev.Reverted {
+				continue
+			}
+			e.dispatch(ev)
+		}
+	}
+}
+
+// dispatch routes ev to whichever handler matches its Kind, ignoring
+// kinds this exporter doesn't track.
+func (e *AlertExporter) dispatch(ev rewardscoordinator.RewardsCoordinatorEvent) {
+	switch ev.Kind {
+	case rewardscoordinator.KindRewardsUpdaterSet:
+		e.handleRewardsUpdaterSet(ev.RewardsUpdaterSet, true)
+	case rewardscoordinator.KindRewardsForAllSubmitterSet:
+		e.handleSubmitterFlagSet(ev.RewardsForAllSubmitterSet, true)
+	case rewardscoordinator.KindRewardsSubmissionForAllCreated:
+		e.handleSubmissionForAllCreated(ev.RewardsSubmissionForAllCreated, true)
+	case rewardscoordinator.KindRewardsSubmissionForAllEarnersCreated:
+		e.handleSubmissionForAllEarnersCreated(ev.RewardsSubmissionForAllEarnersCreated, true)
+	}
+}
@@ -0,0 +1,63 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// seed backfills AlertExporter's counter and gauge state from StartBlock
+// through head via the Filter* iterators, before Run starts its
+// Subscriber watching from head+1. Backfilled events never fire
+// callbacks - only metric state is seeded - so a restart doesn't re-page
+// operators on governance changes they've already been alerted to.
+func (e *AlertExporter) seed(ctx context.Context, head uint64) error {
+	opts := &bind.FilterOpts{Start: e.startBlock, End: &head, Context: ctx}
+
+	updaters, err := e.filterer.FilterRewardsUpdaterSet(opts, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering RewardsUpdaterSet: %w", err)
+	}
+	for updaters.Next() {
+		e.handleRewardsUpdaterSet(updaters.Event, false)
+	}
+	if err := updaters.Error(); err != nil {
+		return fmt.Errorf("error iterating RewardsUpdaterSet: %w", err)
+	}
+
+	flags, err := e.filterer.FilterRewardsForAllSubmitterSet(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering RewardsForAllSubmitterSet: %w", err)
+	}
+	for flags.Next() {
+		e.handleSubmitterFlagSet(flags.Event, false)
+	}
+	if err := flags.Error(); err != nil {
+		return fmt.Errorf("error iterating RewardsForAllSubmitterSet: %w", err)
+	}
+
+	created, err := e.filterer.FilterRewardsSubmissionForAllCreated(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering RewardsSubmissionForAllCreated: %w", err)
+	}
+	for created.Next() {
+		e.handleSubmissionForAllCreated(created.Event, false)
+	}
+	if err := created.Error(); err != nil {
+		return fmt.Errorf("error iterating RewardsSubmissionForAllCreated: %w", err)
+	}
+
+	earners, err := e.filterer.FilterRewardsSubmissionForAllEarnersCreated(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering RewardsSubmissionForAllEarnersCreated: %w", err)
+	}
+	for earners.Next() {
+		e.handleSubmissionForAllEarnersCreated(earners.Event, false)
+	}
+	if err := earners.Error(); err != nil {
+		return fmt.Errorf("error iterating RewardsSubmissionForAllEarnersCreated: %w", err)
+	}
+
+	return nil
+}
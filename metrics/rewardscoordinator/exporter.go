@@ -0,0 +1,183 @@
+// Package rewardscoordinator exports RewardsCoordinator lifecycle events
+// as Prometheus metrics: submission/claim counters, activation-delay and
+// default-split gauges, and a histogram of time between SubmitRoot and
+// root activation. Unlike metrics/rewards (which indexes splits and
+// claimed amounts into a persisted, queryable Store), this package is a
+// drop-in "point it at an RPC endpoint and scrape /metrics" exporter with
+// no storage of its own beyond what Prometheus already tracks.
+package rewardscoordinator
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+const metricsNamespace = "eigensdk_rewardscoordinator"
+
+// eventFilterer is the full RewardsCoordinator binding surface, identical
+// to rewardscoordinator's own (unexported) eventFilterer - redeclared here
+// so Exporter's and AlertExporter's constructors can name it without
+// depending on that package's internals. Each exporter only dispatches on
+// the handful of kinds it tracks, but the Subscriber underlying both needs
+// all fourteen.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+// BlockTimeSource resolves the timestamp a block was mined at, used to
+// measure elapsed time between a DistributionRootSubmitted log and the
+// root's ActivatedAt. Typically backed by ethclient.Client.HeaderByNumber.
+type BlockTimeSource func(blockNumber uint64) (uint64, error)
+
+// Exporter watches RewardsCoordinator lifecycle events and publishes them
+// as Prometheus metrics. Seeding (a one-shot FilterLogs pass over history)
+// and live watching (a rewardscoordinator.Subscriber, reconnecting with
+// backoff on its own) are deliberately separate: Subscriber has no notion
+// of "this delivery is backfill, don't treat it as new," so Exporter seeds
+// its own counters and gauges up through the current head before ever
+// starting the Subscriber, rather than let backfilled and live events
+// arrive on the same stream.
+type Exporter struct {
+	filterer   eventFilterer
+	headFunc   func(ctx context.Context) (uint64, error)
+	sub        *rewardscoordinator.Subscriber
+	blockTime  BlockTimeSource
+	logger     logging.Logger
+	startBlock uint64
+
+	submissionsTotal   *prometheus.CounterVec
+	claimsTotal        *prometheus.CounterVec
+	claimedAmount      *prometheus.CounterVec
+	claimerChanges     *prometheus.CounterVec
+	activationDelay    prometheus.Gauge
+	defaultSplitBips   prometheus.Gauge
+	rootActivationTime prometheus.Histogram
+}
+
+// ExporterConfig customizes an Exporter's backfill range and underlying
+// Subscriber.
+type ExporterConfig struct {
+	// StartBlock is where FilterLogs-based seeding begins.
+	StartBlock uint64
+	Subscriber rewardscoordinator.Config
+}
+
+// NewExporter registers the exporter's metrics against reg and returns an
+// Exporter that reads from filterer. headFunc resolves the current chain
+// head, used both to bound seeding and to start the underlying Subscriber
+// once seeding completes. blockTime may be nil, in which case the
+// SubmitRoot-to-activation histogram is left unpopulated.
+func NewExporter(reg prometheus.Registerer, filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), blockTime BlockTimeSource, config ExporterConfig, logger logging.Logger) (*Exporter, error) {
+	e := &Exporter{
+		filterer:   filterer,
+		headFunc:   headFunc,
+		sub:        rewardscoordinator.New(filterer, headFunc, config.Subscriber, logger),
+		blockTime:  blockTime,
+		logger:     logger,
+		startBlock: config.StartBlock,
+		submissionsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "avs_rewards_submissions_total",
+			Help:      "Total AVSRewardsSubmissionCreated events observed, per AVS.",
+		}, []string{"avs"}),
+		claimsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "claims_total",
+			Help:      "Total RewardsClaimed events observed, per earner and token.",
+		}, []string{"earner", "token"}),
+		claimedAmount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "claimed_amount_cumulative",
+			Help:      "Cumulative amount claimed via RewardsClaimed, per earner and token.",
+		}, []string{"earner", "token"}),
+		claimerChanges: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "claimer_for_set_total",
+			Help:      "Total ClaimerForSet events observed, per earner.",
+		}, []string{"earner"}),
+		activationDelay: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "activation_delay_seconds",
+			Help:      "Current RewardsCoordinator ActivationDelay, as last set by ActivationDelaySet.",
+		}),
+		defaultSplitBips: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "default_operator_split_bips",
+			Help:      "Current protocol-wide default operator split, in bips, as last set by DefaultOperatorSplitBipsSet.",
+		}),
+		rootActivationTime: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "root_activation_seconds",
+			Help:      "Seconds between a DistributionRootSubmitted log's block and the root's ActivatedAt.",
+			Buckets:   prometheus.ExponentialBuckets(60, 2, 12),
+		}),
+	}
+
+	collectors := []prometheus.Collector{
+		e.submissionsTotal, e.claimsTotal, e.claimedAmount, e.claimerChanges,
+		e.activationDelay, e.defaultSplitBips, e.rootActivationTime,
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Handler returns an http.Handler serving this exporter's metrics in the
+// Prometheus text exposition format, for mounting at /metrics.
+func Handler(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+}
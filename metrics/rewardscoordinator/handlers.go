@@ -0,0 +1,52 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"math/big"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+func (e *Exporter) handleSubmission(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated) {
+	e.submissionsTotal.WithLabelValues(ev.Avs.Hex()).Inc()
+}
+
+func (e *Exporter) handleActivationDelay(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) {
+	e.activationDelay.Set(float64(ev.NewActivationDelay))
+}
+
+func (e *Exporter) handleDefaultSplit(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) {
+	e.defaultSplitBips.Set(float64(ev.NewDefaultOperatorSplitBips))
+}
+
+func (e *Exporter) handleClaimerForSet(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet) {
+	e.claimerChanges.WithLabelValues(ev.Earner.Hex()).Inc()
+}
+
+func (e *Exporter) handleClaim(ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed) {
+	earner, token := ev.Earner.Hex(), ev.Token.Hex()
+	e.claimsTotal.WithLabelValues(earner, token).Inc()
+	amount, _ := new(big.Float).SetInt(ev.ClaimedAmount).Float64()
+	e.claimedAmount.WithLabelValues(earner, token).Add(amount)
+}
+
+// handleRootSubmitted observes rootActivationTime for one
+// DistributionRootSubmitted log: ev.ActivatedAt is already carried on the
+// event, but the submitting block's own timestamp isn't, so it's resolved
+// via blockTime. The observation is skipped - rather than failing the
+// whole seed/subscribe loop - when no BlockTimeSource was configured or
+// the lookup errors, since the histogram is best-effort.
+func (e *Exporter) handleRootSubmitted(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted) {
+	if e.blockTime == nil {
+		return
+	}
+	submittedAt, err := e.blockTime(ev.Raw.BlockNumber)
+	if err != nil {
+		e.logger.Error("error resolving block time for DistributionRootSubmitted", "block", ev.Raw.BlockNumber, "err", err)
+		return
+	}
+	if uint64(ev.ActivatedAt) <= submittedAt {
+		return
+	}
+	e.rootActivationTime.Observe(float64(uint64(ev.ActivatedAt) - submittedAt))
+}
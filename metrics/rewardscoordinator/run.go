@@ -0,0 +1,72 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"fmt"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+)
+
+// Run seeds metric state from history through the current head, then
+// watches every event this exporter tracks - via its underlying Subscriber
+// - until ctx is canceled. The Subscriber already reconnects each kind
+// independently with backoff and re-delivers reorged-out events with
+// Reverted set; Reverted deliveries are dropped here rather than undone,
+// since a Prometheus counter has no sound way to "un-increment" and a
+// reorg shallow enough to flip one of these events is rare enough not to
+// warrant tracking prior gauge values just to roll them back.
+func (e *Exporter) Run(ctx context.Context) error {
+	head, err := e.headFunc(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading chain head: %w", err)
+	}
+	if err := e.seed(ctx, head); err != nil {
+		return fmt.Errorf("error seeding rewardscoordinator metrics: %w", err)
+	}
+
+	raw := make(chan rewardscoordinator.RewardsCoordinatorEvent)
+	sub, err := e.sub.Subscribe(ctx, head+1, raw)
+	if err != nil {
+		return fmt.Errorf("error subscribing to RewardsCoordinator events: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-sub.Err():
+			if ok && err != nil {
+				e.logger.Error("error in RewardsCoordinator subscription", "err", err)
+			}
+			return nil
+		case ev, ok := <-raw:
+			if !ok {
+				return nil
+			}
+			if ev.Reverted {
+				continue
+			}
+			e.dispatch(ctx, ev)
+		}
+	}
+}
+
+// dispatch routes ev to whichever handler matches its Kind, ignoring kinds
+// this exporter doesn't track.
+func (e *Exporter) dispatch(ctx context.Context, ev rewardscoordinator.RewardsCoordinatorEvent) {
+	switch ev.Kind {
+	case rewardscoordinator.KindAVSRewardsSubmissionCreated:
+		e.handleSubmission(ev.AVSRewardsSubmissionCreated)
+	case rewardscoordinator.KindActivationDelaySet:
+		e.handleActivationDelay(ev.ActivationDelaySet)
+	case rewardscoordinator.KindClaimerForSet:
+		e.handleClaimerForSet(ev.ClaimerForSet)
+	case rewardscoordinator.KindDefaultOperatorSplitBipsSet:
+		e.handleDefaultSplit(ev.DefaultOperatorSplitBipsSet)
+	case rewardscoordinator.KindRewardsClaimed:
+		e.handleClaim(ev.RewardsClaimed)
+	case rewardscoordinator.KindDistributionRootSubmitted:
+		e.handleRootSubmitted(ctx, ev.DistributionRootSubmitted)
+	}
+}
@@ -0,0 +1,86 @@
+package rewardscoordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// seed backfills counter and gauge state from StartBlock through head via
+// the Filter* iterators, before Run starts its Subscriber watching from
+// head+1. Counters seeded this way reflect historical totals rather than
+// starting at zero on every restart, and bounding seeding at the same
+// head Run then subscribes from means no block is seeded and delivered
+// live both.
+func (e *Exporter) seed(ctx context.Context, head uint64) error {
+	opts := &bind.FilterOpts{Start: e.startBlock, End: &head, Context: ctx}
+
+	submissions, err := e.filterer.FilterAVSRewardsSubmissionCreated(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering AVSRewardsSubmissionCreated: %w", err)
+	}
+	for submissions.Next() {
+		e.handleSubmission(submissions.Event)
+	}
+	if err := submissions.Error(); err != nil {
+		return fmt.Errorf("error iterating AVSRewardsSubmissionCreated: %w", err)
+	}
+
+	delays, err := e.filterer.FilterActivationDelaySet(opts)
+	if err != nil {
+		return fmt.Errorf("error filtering ActivationDelaySet: %w", err)
+	}
+	for delays.Next() {
+		e.handleActivationDelay(delays.Event)
+	}
+	if err := delays.Error(); err != nil {
+		return fmt.Errorf("error iterating ActivationDelaySet: %w", err)
+	}
+
+	splits, err := e.filterer.FilterDefaultOperatorSplitBipsSet(opts)
+	if err != nil {
+		return fmt.Errorf("error filtering DefaultOperatorSplitBipsSet: %w", err)
+	}
+	for splits.Next() {
+		e.handleDefaultSplit(splits.Event)
+	}
+	if err := splits.Error(); err != nil {
+		return fmt.Errorf("error iterating DefaultOperatorSplitBipsSet: %w", err)
+	}
+
+	claimers, err := e.filterer.FilterClaimerForSet(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering ClaimerForSet: %w", err)
+	}
+	for claimers.Next() {
+		e.handleClaimerForSet(claimers.Event)
+	}
+	if err := claimers.Error(); err != nil {
+		return fmt.Errorf("error iterating ClaimerForSet: %w", err)
+	}
+
+	claims, err := e.filterer.FilterRewardsClaimed(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering RewardsClaimed: %w", err)
+	}
+	for claims.Next() {
+		e.handleClaim(claims.Event)
+	}
+	if err := claims.Error(); err != nil {
+		return fmt.Errorf("error iterating RewardsClaimed: %w", err)
+	}
+
+	roots, err := e.filterer.FilterDistributionRootSubmitted(opts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error filtering DistributionRootSubmitted: %w", err)
+	}
+	for roots.Next() {
+		e.handleRootSubmitted(ctx, roots.Event)
+	}
+	if err := roots.Error(); err != nil {
+		return fmt.Errorf("error iterating DistributionRootSubmitted: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Package claimcache turns DistributionRootSubmitted events into a
+// ready-to-submit "claim daemon" primitive: it watches the
+// RewardsCoordinator for new roots, fetches the corresponding off-chain
+// distribution JSON via a pluggable RootDataFetcher, verifies the
+// Merkle root matches what was posted on-chain, and caches the resulting
+// Distribution so GetLatestClaimForEarner can hand back a claim without
+// any further network round-trip. Entries are evicted when
+// DistributionRootDisabled fires for their RootIndex.
+package claimcache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	merkle "github.com/Layr-Labs/eigensdk-go/rewards/merkle"
+)
+
+// RewardsMerkleClaim is a convenience alias for the generated struct.
+type RewardsMerkleClaim = contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+
+// eventFilterer is the subset of the RewardsCoordinator binding this
+// cache watches.
+type eventFilterer interface {
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+}
+
+// Cache watches for new distribution roots and serves ready-to-submit
+// claims for any earner in the latest verified one.
+type Cache struct {
+	filterer eventFilterer
+	fetcher  RootDataFetcher
+	store    Store
+	logger   logging.Logger
+}
+
+// New returns a Cache reading root submissions from filterer, fetching
+// their distribution JSON via fetcher, and caching the result in store.
+func New(filterer eventFilterer, fetcher RootDataFetcher, store Store, logger logging.Logger) *Cache {
+	return &Cache{filterer: filterer, fetcher: fetcher, store: store, logger: logger}
+}
+
+// Run watches DistributionRootSubmitted and DistributionRootDisabled
+// until ctx is canceled, updating store as they arrive.
+func (c *Cache) Run(ctx context.Context) error {
+	roots := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted)
+	rootsSub, err := c.filterer.WatchDistributionRootSubmitted(&bind.WatchOpts{Context: ctx}, roots, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error watching DistributionRootSubmitted: %w", err)
+	}
+	defer rootsSub.Unsubscribe()
+
+	disabled := make(chan *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled)
+	disabledSub, err := c.filterer.WatchDistributionRootDisabled(&bind.WatchOpts{Context: ctx}, disabled, nil)
+	if err != nil {
+		return fmt.Errorf("error watching DistributionRootDisabled: %w", err)
+	}
+	defer disabledSub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-rootsSub.Err():
+			return fmt.Errorf("DistributionRootSubmitted subscription ended: %w", err)
+		case err := <-disabledSub.Err():
+			return fmt.Errorf("DistributionRootDisabled subscription ended: %w", err)
+		case ev := <-roots:
+			if err := c.handleRootSubmitted(ctx, ev); err != nil {
+				c.logger.Error("error caching distribution root", "rootIndex", ev.RootIndex, "err", err)
+			}
+		case ev := <-disabled:
+			if err := c.store.EvictRoot(ctx, ev.RootIndex); err != nil {
+				c.logger.Error("error evicting disabled distribution root", "rootIndex", ev.RootIndex, "err", err)
+			}
+		}
+	}
+}
+
+func (c *Cache) handleRootSubmitted(ctx context.Context, ev *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted) error {
+	body, err := c.fetcher.Fetch(ctx, ev.RootIndex, ev.Root)
+	if err != nil {
+		return fmt.Errorf("error fetching distribution data: %w", err)
+	}
+	defer body.Close()
+
+	dist, err := merkle.ParseDistribution(body)
+	if err != nil {
+		return fmt.Errorf("error parsing distribution data: %w", err)
+	}
+	if dist.Root() != ev.Root {
+		return fmt.Errorf("fetched distribution's root %x does not match posted root %x for index %d", dist.Root(), ev.Root, ev.RootIndex)
+	}
+
+	if err := c.store.SaveDistribution(ctx, ev.RootIndex, dist); err != nil {
+		return fmt.Errorf("error saving distribution: %w", err)
+	}
+	if err := c.store.SetLatestRootIndex(ctx, ev.RootIndex); err != nil {
+		return fmt.Errorf("error updating latest root index: %w", err)
+	}
+	return nil
+}
+
+// GetLatestClaimForEarner returns a ready-to-submit claim for earner
+// restricted to token, drawn from the most recently verified distribution
+// root, with RootIndex already filled in.
+func (c *Cache) GetLatestClaimForEarner(ctx context.Context, earner common.Address, token common.Address) (*RewardsMerkleClaim, error) {
+	index, ok, err := c.store.LatestRootIndex(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading latest root index: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("no distribution root cached yet")
+	}
+	dist, ok, err := c.store.Distribution(ctx, index)
+	if err != nil {
+		return nil, fmt.Errorf("error reading distribution for root index %d: %w", index, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("distribution for root index %d is not cached", index)
+	}
+
+	claim, err := dist.Claim(earner)
+	if err != nil {
+		return nil, fmt.Errorf("error building claim for earner %s: %w", earner, err)
+	}
+	claim.RootIndex = index
+
+	for i, leaf := range claim.TokenLeaves {
+		if leaf.Token == token {
+			claim.TokenIndices = claim.TokenIndices[i : i+1]
+			claim.TokenTreeProofs = claim.TokenTreeProofs[i : i+1]
+			claim.TokenLeaves = claim.TokenLeaves[i : i+1]
+			return &claim, nil
+		}
+	}
+	return nil, fmt.Errorf("earner %s has no entry for token %s in root index %d", earner, token, index)
+}
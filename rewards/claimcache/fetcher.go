@@ -0,0 +1,43 @@
+package claimcache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// RootDataFetcher retrieves the off-chain distribution JSON published
+// alongside a given distribution root, from wherever the rewards updater
+// put it - S3, IPFS, or (as HTTPFetcher does) a plain HTTP(S) endpoint.
+type RootDataFetcher interface {
+	Fetch(ctx context.Context, rootIndex uint32, root [32]byte) (io.ReadCloser, error)
+}
+
+// HTTPFetcher fetches distribution JSON from a URL derived from the root,
+// via URLForRoot.
+type HTTPFetcher struct {
+	Client     *http.Client
+	URLForRoot func(rootIndex uint32, root [32]byte) string
+}
+
+// Fetch implements RootDataFetcher.
+func (f HTTPFetcher) Fetch(ctx context.Context, rootIndex uint32, root [32]byte) (io.ReadCloser, error) {
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.URLForRoot(rootIndex, root), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building request for root %x: %w", root, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching distribution for root %x: %w", root, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("fetching distribution for root %x: unexpected status %s", root, resp.Status)
+	}
+	return resp.Body, nil
+}
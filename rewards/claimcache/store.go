@@ -0,0 +1,79 @@
+package claimcache
+
+import (
+	"context"
+	"sync"
+
+	merkle "github.com/Layr-Labs/eigensdk-go/rewards/merkle"
+)
+
+// Store persists the Distributions this cache has fetched and verified,
+// keyed by the RootIndex they were posted at, along with which index is
+// currently the latest active one. The interface is deliberately narrow
+// so it can be backed by BoltDB or Badger instead of MemoryStore's
+// in-process maps.
+type Store interface {
+	SaveDistribution(ctx context.Context, rootIndex uint32, dist *merkle.Distribution) error
+	Distribution(ctx context.Context, rootIndex uint32) (*merkle.Distribution, bool, error)
+	SetLatestRootIndex(ctx context.Context, rootIndex uint32) error
+	LatestRootIndex(ctx context.Context) (uint32, bool, error)
+	EvictRoot(ctx context.Context, rootIndex uint32) error
+}
+
+// MemoryStore is an in-process Store with no persistence across restarts.
+type MemoryStore struct {
+	mu            sync.RWMutex
+	distributions map[uint32]*merkle.Distribution
+	latest        uint32
+	hasLatest     bool
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{distributions: make(map[uint32]*merkle.Distribution)}
+}
+
+// SaveDistribution implements Store.
+func (m *MemoryStore) SaveDistribution(ctx context.Context, rootIndex uint32, dist *merkle.Distribution) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.distributions[rootIndex] = dist
+	return nil
+}
+
+// Distribution implements Store.
+func (m *MemoryStore) Distribution(ctx context.Context, rootIndex uint32) (*merkle.Distribution, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	dist, ok := m.distributions[rootIndex]
+	return dist, ok, nil
+}
+
+// SetLatestRootIndex implements Store.
+func (m *MemoryStore) SetLatestRootIndex(ctx context.Context, rootIndex uint32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latest = rootIndex
+	m.hasLatest = true
+	return nil
+}
+
+// LatestRootIndex implements Store.
+func (m *MemoryStore) LatestRootIndex(ctx context.Context) (uint32, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.latest, m.hasLatest, nil
+}
+
+// EvictRoot implements Store. If the evicted root was the latest one, the
+// cache no longer has a latest root index until the next
+// DistributionRootSubmitted arrives.
+func (m *MemoryStore) EvictRoot(ctx context.Context, rootIndex uint32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.distributions, rootIndex)
+	if m.hasLatest && m.latest == rootIndex {
+		m.hasLatest = false
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package claimer
+
+import (
+	"context"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// GasReport compares the estimated gas of submitting a Plan's batches
+// against submitting one ProcessClaim per claim, so a caller can see the
+// savings batching produced before broadcasting anything.
+type GasReport struct {
+	ClaimCount  int
+	BatchCount  int
+	PerClaimGas uint64
+	BatchedGas  uint64
+}
+
+// Savings returns how much estimated gas batching saved versus one
+// ProcessClaim per claim. It's negative if batching happened to cost more
+// (e.g. a single-claim plan, where ProcessClaims' overhead exceeds
+// ProcessClaim's).
+func (r GasReport) Savings() int64 {
+	return int64(r.PerClaimGas) - int64(r.BatchedGas)
+}
+
+// DryRun estimates, but does not send, the gas cost of plan's batches, and
+// compares it against submitting every claim individually via
+// ProcessClaim.
+func (p *Planner) DryRun(ctx context.Context, plan *Plan) (GasReport, error) {
+	report := GasReport{ClaimCount: plan.ClaimCount(), BatchCount: len(plan.Batches)}
+
+	for _, batch := range plan.Batches {
+		gas, err := p.estimateProcessClaims(ctx, batch.Claims)
+		if err != nil {
+			return report, fmt.Errorf("error estimating batched gas for root %d: %w", batch.RootIndex, err)
+		}
+		report.BatchedGas += gas
+
+		for _, claim := range batch.Claims {
+			gas, err := p.estimateProcessClaim(ctx, claim)
+			if err != nil {
+				return report, fmt.Errorf("error estimating per-claim gas for earner %s: %w", claim.EarnerLeaf.Earner, err)
+			}
+			report.PerClaimGas += gas
+		}
+	}
+	return report, nil
+}
+
+func (p *Planner) estimateProcessClaim(ctx context.Context, claim RewardsMerkleClaim) (uint64, error) {
+	parsedABI, err := contractIRewardsCoordinator.ContractIRewardsCoordinatorMetaData.GetAbi()
+	if err != nil {
+		return 0, fmt.Errorf("error parsing RewardsCoordinator ABI: %w", err)
+	}
+	calldata, err := parsedABI.Pack("processClaim", claim, p.recipient)
+	if err != nil {
+		return 0, fmt.Errorf("error encoding ProcessClaim calldata: %w", err)
+	}
+	return p.ethClient.EstimateGas(ctx, ethereum.CallMsg{To: &p.rewardsAddress, Data: calldata})
+}
@@ -0,0 +1,204 @@
+// Package claimer packs many earners' RewardsCoordinator claims into as
+// few ProcessClaims transactions as possible: it groups claims by
+// rootIndex, packs them up to a configurable gas ceiling, and falls back
+// to individual ProcessClaim calls for any batch that turns out to have a
+// stale proof or otherwise reverts.
+package claimer
+
+import (
+	"context"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/Layr-Labs/eigensdk-go/services/rewards"
+)
+
+// RewardsMerkleClaim is a convenience alias for the generated struct, so
+// callers of this package don't need to import the bindings package
+// directly.
+type RewardsMerkleClaim = contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+
+// Payout identifies one earner's desired payout of a token. A single
+// on-chain claim proves every token an earner has accrued against a root
+// at once, so Payouts for the same earner collapse to a single claim in
+// the resulting Plan; Token is kept on the struct purely so callers can
+// express "pay out earner E's token T" without needing to know that.
+type Payout struct {
+	Earner common.Address
+	Token  common.Address
+}
+
+// gasEstimator is the subset of bind.ContractBackend needed to size
+// batches against a gas ceiling and to report dry-run gas savings.
+type gasEstimator interface {
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
+// Batch is one planned ProcessClaims call: every claim in it shares a
+// RootIndex and together they fit under the Planner's gas ceiling, unless
+// the batch holds a single claim that exceeds the ceiling by itself.
+type Batch struct {
+	RootIndex uint32
+	Claims    []RewardsMerkleClaim
+}
+
+// Plan is the output of Planner.Build: a set of gas-bounded batches ready
+// to submit, in the order they were packed.
+type Plan struct {
+	Batches []Batch
+}
+
+// ClaimCount returns the total number of claims across every batch in p.
+func (p *Plan) ClaimCount() int {
+	n := 0
+	for _, b := range p.Batches {
+		n += len(b.Claims)
+	}
+	return n
+}
+
+// PlannerConfig bounds how large a single ProcessClaims batch is allowed
+// to get.
+type PlannerConfig struct {
+	// MaxGasPerTx is the estimated-gas ceiling a batch may not exceed,
+	// except that a claim whose estimate alone exceeds it is still given a
+	// batch of its own. Zero disables the ceiling, packing every claim for
+	// a root into one batch.
+	MaxGasPerTx uint64
+}
+
+// Planner builds gas-bounded ProcessClaims batches from a set of desired
+// payouts, rebuilding each claim against whichever DistributionRoot is
+// currently claimable for its earner.
+type Planner struct {
+	builder        *rewards.ClaimBuilder
+	ethClient      gasEstimator
+	rewardsAddress common.Address
+	recipient      common.Address
+	config         PlannerConfig
+	logger         logging.Logger
+}
+
+// NewPlanner returns a Planner that resolves claims via builder and
+// estimates gas for processClaims calldata addressed to rewardsAddress.
+func NewPlanner(
+	builder *rewards.ClaimBuilder,
+	ethClient gasEstimator,
+	rewardsAddress common.Address,
+	recipient common.Address,
+	config PlannerConfig,
+	logger logging.Logger,
+) *Planner {
+	return &Planner{
+		builder:        builder,
+		ethClient:      ethClient,
+		rewardsAddress: rewardsAddress,
+		recipient:      recipient,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+// Build resolves one claim per distinct earner in payouts, groups them by
+// the root index each was proven against, and packs each group into
+// batches no larger than PlannerConfig.MaxGasPerTx estimated gas, apart
+// from single-claim batches whose one claim already exceeds it.
+func (p *Planner) Build(ctx context.Context, payouts []Payout) (*Plan, error) {
+	claims, err := p.resolveClaims(ctx, payouts)
+	if err != nil {
+		return nil, err
+	}
+	return p.pack(ctx, claims)
+}
+
+// resolveClaims builds one claim per distinct earner in payouts, in the
+// order earners first appear.
+func (p *Planner) resolveClaims(ctx context.Context, payouts []Payout) ([]RewardsMerkleClaim, error) {
+	seen := make(map[common.Address]bool)
+	var claims []RewardsMerkleClaim
+	for _, payout := range payouts {
+		if seen[payout.Earner] {
+			continue
+		}
+		seen[payout.Earner] = true
+		claim, err := p.builder.BuildClaim(ctx, payout.Earner)
+		if err != nil {
+			return nil, fmt.Errorf("error building claim for earner %s: %w", payout.Earner, err)
+		}
+		claims = append(claims, claim)
+	}
+	return claims, nil
+}
+
+// pack groups claims by RootIndex, then greedily fills each group's
+// batches up to PlannerConfig.MaxGasPerTx.
+func (p *Planner) pack(ctx context.Context, claims []RewardsMerkleClaim) (*Plan, error) {
+	var order []uint32
+	groups := make(map[uint32][]RewardsMerkleClaim)
+	for _, claim := range claims {
+		if _, ok := groups[claim.RootIndex]; !ok {
+			order = append(order, claim.RootIndex)
+		}
+		groups[claim.RootIndex] = append(groups[claim.RootIndex], claim)
+	}
+
+	plan := &Plan{}
+	for _, rootIndex := range order {
+		batches, err := p.packGroup(ctx, rootIndex, groups[rootIndex])
+		if err != nil {
+			return nil, err
+		}
+		plan.Batches = append(plan.Batches, batches...)
+	}
+	return plan, nil
+}
+
+func (p *Planner) packGroup(ctx context.Context, rootIndex uint32, claims []RewardsMerkleClaim) ([]Batch, error) {
+	if p.config.MaxGasPerTx == 0 {
+		return []Batch{{RootIndex: rootIndex, Claims: claims}}, nil
+	}
+
+	var batches []Batch
+	var current []RewardsMerkleClaim
+	for _, claim := range claims {
+		candidate := append(append([]RewardsMerkleClaim(nil), current...), claim)
+		gas, err := p.estimateProcessClaims(ctx, candidate)
+		if err != nil {
+			return nil, fmt.Errorf("error estimating ProcessClaims gas for root %d: %w", rootIndex, err)
+		}
+		if gas > p.config.MaxGasPerTx && len(current) > 0 {
+			batches = append(batches, Batch{RootIndex: rootIndex, Claims: current})
+			current = []RewardsMerkleClaim{claim}
+			continue
+		}
+		current = candidate
+	}
+	if len(current) > 0 {
+		batches = append(batches, Batch{RootIndex: rootIndex, Claims: current})
+	}
+	return batches, nil
+}
+
+func (p *Planner) estimateProcessClaims(ctx context.Context, claims []RewardsMerkleClaim) (uint64, error) {
+	calldata, err := p.encodeProcessClaims(claims)
+	if err != nil {
+		return 0, err
+	}
+	return p.ethClient.EstimateGas(ctx, ethereum.CallMsg{To: &p.rewardsAddress, Data: calldata})
+}
+
+func (p *Planner) encodeProcessClaims(claims []RewardsMerkleClaim) ([]byte, error) {
+	parsedABI, err := contractIRewardsCoordinator.ContractIRewardsCoordinatorMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing RewardsCoordinator ABI: %w", err)
+	}
+	calldata, err := parsedABI.Pack("processClaims", claims, p.recipient)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding ProcessClaims calldata: %w", err)
+	}
+	return calldata, nil
+}
@@ -0,0 +1,153 @@
+package claimer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// claimsTransactor is the subset of RewardsCoordinator write methods
+// needed to submit a batch, or a single claim as a fallback.
+type claimsTransactor interface {
+	ProcessClaims(opts *bind.TransactOpts, claims []RewardsMerkleClaim, recipient common.Address) (*types.Transaction, error)
+	ProcessClaim(opts *bind.TransactOpts, claim RewardsMerkleClaim, recipient common.Address) (*types.Transaction, error)
+}
+
+// contractCaller is the subset of bind.ContractCaller used to simulate a
+// batch before sending it, so a stale proof is caught and repaired
+// up front instead of burning gas on a revert.
+type contractCaller interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// Result is the outcome of submitting one planned Batch: either the batch
+// succeeded as one transaction, or it was split into individual
+// per-earner fallback transactions (Fallback is non-empty in that case).
+type Result struct {
+	RootIndex uint32
+	Tx        *types.Transaction
+	Fallback  []ClaimResult
+	Err       error
+}
+
+// ClaimResult is the outcome of one fallback per-earner ProcessClaim call.
+type ClaimResult struct {
+	Claim RewardsMerkleClaim
+	Tx    *types.Transaction
+	Err   error
+}
+
+// Submitter sends a Plan's batches, simulating each one first to catch a
+// stale proof (a newer DistributionRoot superseding the one the batch was
+// built against) and falling back to one ProcessClaim per earner when a
+// batch can't be sent as-is.
+type Submitter struct {
+	planner    *Planner
+	transactor claimsTransactor
+	caller     contractCaller
+}
+
+// NewSubmitter returns a Submitter that plans through planner and submits
+// through transactor, using caller to simulate batches before sending.
+func NewSubmitter(planner *Planner, transactor claimsTransactor, caller contractCaller) *Submitter {
+	return &Submitter{planner: planner, transactor: transactor, caller: caller}
+}
+
+// Submit sends every batch in plan synchronously, in order, returning one
+// Result per batch. A batch that reverts on simulation is rebuilt against
+// the current claimable root once; if it still fails, its claims are
+// resubmitted individually via ProcessClaim so one stale earner doesn't
+// block the rest of the batch.
+func (s *Submitter) Submit(ctx context.Context, opts *bind.TransactOpts, recipient common.Address, plan *Plan) []Result {
+	results := make([]Result, 0, len(plan.Batches))
+	for _, batch := range plan.Batches {
+		results = append(results, s.submitBatch(ctx, opts, recipient, batch))
+	}
+	return results
+}
+
+// SubmitAsync runs Submit in a goroutine, streaming each batch's Result as
+// it completes rather than waiting for the whole plan.
+func (s *Submitter) SubmitAsync(ctx context.Context, opts *bind.TransactOpts, recipient common.Address, plan *Plan) <-chan Result {
+	out := make(chan Result, len(plan.Batches))
+	go func() {
+		defer close(out)
+		for _, batch := range plan.Batches {
+			out <- s.submitBatch(ctx, opts, recipient, batch)
+		}
+	}()
+	return out
+}
+
+func (s *Submitter) submitBatch(ctx context.Context, opts *bind.TransactOpts, recipient common.Address, batch Batch) Result {
+	claims := batch.Claims
+	if err := s.simulate(ctx, opts.From, claims); err != nil {
+		rebuilt, rebuildErr := s.rebuild(ctx, claims)
+		if rebuildErr != nil {
+			return s.fallback(ctx, opts, recipient, batch.RootIndex, claims, fmt.Errorf("stale batch and rebuild failed: %w", err))
+		}
+		claims = rebuilt
+		if err := s.simulate(ctx, opts.From, claims); err != nil {
+			return s.fallback(ctx, opts, recipient, batch.RootIndex, claims, fmt.Errorf("batch still reverts after rebuild: %w", err))
+		}
+	}
+
+	tx, err := s.transactor.ProcessClaims(opts, claims, recipient)
+	if err != nil {
+		return s.fallback(ctx, opts, recipient, batch.RootIndex, claims, fmt.Errorf("error submitting batch: %w", err))
+	}
+	return Result{RootIndex: batch.RootIndex, Tx: tx}
+}
+
+// simulate eth_calls processClaims ahead of sending, so a stale proof is
+// caught as a simulation failure rather than a mined revert. It uses the
+// Planner's configured recipient, which the caller's recipient argument
+// must match - the batch's gas estimate was packed against that same
+// calldata.
+func (s *Submitter) simulate(ctx context.Context, from common.Address, claims []RewardsMerkleClaim) error {
+	calldata, err := s.planner.encodeProcessClaims(claims)
+	if err != nil {
+		return err
+	}
+	_, err = s.caller.CallContract(ctx, ethereum.CallMsg{From: from, To: &s.planner.rewardsAddress, Data: calldata}, nil)
+	return err
+}
+
+// rebuild re-resolves every claim in claims against whichever root is
+// currently claimable for its earner, in case a newer root superseded the
+// one the batch was originally packed against.
+func (s *Submitter) rebuild(ctx context.Context, claims []RewardsMerkleClaim) ([]RewardsMerkleClaim, error) {
+	rebuilt := make([]RewardsMerkleClaim, 0, len(claims))
+	for _, claim := range claims {
+		earner := claim.EarnerLeaf.Earner
+		fresh, err := s.planner.builder.BuildClaim(ctx, earner)
+		if err != nil {
+			return nil, fmt.Errorf("error rebuilding claim for earner %s: %w", earner, err)
+		}
+		rebuilt = append(rebuilt, fresh)
+	}
+	return rebuilt, nil
+}
+
+// fallback resubmits every claim in a failed batch individually, so one
+// bad claim doesn't block the rest from going through.
+func (s *Submitter) fallback(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	recipient common.Address,
+	rootIndex uint32,
+	claims []RewardsMerkleClaim,
+	batchErr error,
+) Result {
+	fallback := make([]ClaimResult, 0, len(claims))
+	for _, claim := range claims {
+		tx, err := s.transactor.ProcessClaim(opts, claim, recipient)
+		fallback = append(fallback, ClaimResult{Claim: claim, Tx: tx, Err: err})
+	}
+	return Result{RootIndex: rootIndex, Fallback: fallback, Err: batchErr}
+}
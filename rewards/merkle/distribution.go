@@ -0,0 +1,145 @@
+// Package merkle builds RewardsCoordinator claims entirely off-chain from
+// the canonical earner distribution JSON the rewards updater publishes,
+// so that submitting a ProcessClaim no longer requires EigenLabs' hosted
+// proof API. It rebuilds the same two-level earner/token Merkle tree the
+// contract verifies, computes the resulting distributionRoot, and can
+// cross-check that root against the chain before a claim is submitted.
+package merkle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/elcontracts/rewardsmerkle"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/services/rewards"
+)
+
+// RewardsMerkleClaim is a convenience alias for the generated struct, so
+// callers of this package don't need to import the bindings package
+// directly.
+type RewardsMerkleClaim = contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+
+// tokenEntry is one earner's per-token line in the distribution JSON.
+type tokenEntry struct {
+	Token              common.Address `json:"token"`
+	CumulativeEarnings string         `json:"cumulativeEarnings"`
+}
+
+// earnerEntry is one earner's full record in the distribution JSON.
+type earnerEntry struct {
+	Earner common.Address `json:"earner"`
+	Tokens []tokenEntry   `json:"tokens"`
+}
+
+// Distribution is a fully-built earner/token Merkle tree for one
+// distributionRoot, ready to produce claims for any earner it contains.
+type Distribution struct {
+	tree *rewardsmerkle.ClaimTree
+}
+
+// ParseDistribution reads the canonical distribution JSON format - a flat
+// array of earner records, each with its per-token cumulative earnings -
+// and rebuilds the tree the RewardsCoordinator expects.
+func ParseDistribution(r io.Reader) (*Distribution, error) {
+	var entries []earnerEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("error decoding distribution JSON: %w", err)
+	}
+
+	leaves := make(map[common.Address][]rewardsmerkle.Earning, len(entries))
+	for _, e := range entries {
+		earnings := make([]rewardsmerkle.Earning, 0, len(e.Tokens))
+		for _, t := range e.Tokens {
+			amount, ok := new(big.Int).SetString(t.CumulativeEarnings, 10)
+			if !ok {
+				return nil, fmt.Errorf("error parsing cumulativeEarnings %q for earner %s token %s", t.CumulativeEarnings, e.Earner, t.Token)
+			}
+			earnings = append(earnings, rewardsmerkle.Earning{Token: t.Token, CumulativeEarnings: amount})
+		}
+		leaves[e.Earner] = earnings
+	}
+
+	tree, err := rewardsmerkle.BuildClaimTree(leaves)
+	if err != nil {
+		return nil, fmt.Errorf("error building claim tree: %w", err)
+	}
+	return &Distribution{tree: tree}, nil
+}
+
+// Root returns the distributionRoot this Distribution's tree hashes to.
+func (d *Distribution) Root() [32]byte {
+	return d.tree.Root()
+}
+
+// Claim produces the RewardsMerkleClaim for earner, with RootIndex left at
+// zero; callers submitting directly should set it via Verify first.
+func (d *Distribution) Claim(earner common.Address) (RewardsMerkleClaim, error) {
+	claim, err := d.tree.ProveEarner(earner)
+	if err != nil {
+		return RewardsMerkleClaim{}, fmt.Errorf("error proving earner %s: %w", earner, err)
+	}
+	return claim, nil
+}
+
+// Claims produces claims for every earner in earners in one pass,
+// returning a partial result alongside the first error encountered so a
+// caller assembling a large batch can see which earners succeeded.
+func (d *Distribution) Claims(earners []common.Address) ([]RewardsMerkleClaim, error) {
+	claims := make([]RewardsMerkleClaim, 0, len(earners))
+	for _, earner := range earners {
+		claim, err := d.Claim(earner)
+		if err != nil {
+			return claims, err
+		}
+		claims = append(claims, claim)
+	}
+	return claims, nil
+}
+
+// Verify cross-checks d's root against the chain via
+// GetRootIndexFromHash/GetDistributionRootAtIndex, returning the RootIndex
+// to use on any claim produced from d. It fails if the root hasn't been
+// posted, or if the posted root at that index doesn't match d's root
+// exactly - guarding against a corrupted or stale distribution file being
+// submitted on-chain.
+func Verify(ctx context.Context, reader rewards.DistributionRootsReader, d *Distribution) (uint32, error) {
+	root := d.Root()
+	index, err := reader.GetRootIndexFromHash(&bind.CallOpts{Context: ctx}, root)
+	if err != nil {
+		return 0, fmt.Errorf("computed root %x is not a posted distribution root: %w", root, err)
+	}
+	posted, err := reader.GetDistributionRootAtIndex(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(uint64(index)))
+	if err != nil {
+		return 0, fmt.Errorf("error fetching distribution root at index %d: %w", index, err)
+	}
+	if posted.Root != root {
+		return 0, fmt.Errorf("posted root at index %d (%x) does not match computed root (%x)", index, posted.Root, root)
+	}
+	return index, nil
+}
+
+// VerifyAndClaim is a convenience wrapper that runs Verify, then fills in
+// RootIndex on every claim produced for earners.
+func VerifyAndClaim(
+	ctx context.Context,
+	reader rewards.DistributionRootsReader,
+	d *Distribution,
+	earners []common.Address,
+) ([]RewardsMerkleClaim, error) {
+	index, err := Verify(ctx, reader, d)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := d.Claims(earners)
+	for i := range claims {
+		claims[i].RootIndex = index
+	}
+	return claims, err
+}
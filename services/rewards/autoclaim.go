@@ -0,0 +1,86 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// AutoClaimerConfig controls which earners AutoClaimer watches and where it
+// sends their claimed rewards.
+type AutoClaimerConfig struct {
+	// Earners is the set of earner addresses to claim on behalf of when
+	// a new DistributionRoot is posted.
+	Earners []common.Address
+	// RecipientOf returns the payout recipient for an earner. If nil,
+	// the earner address itself is used as the recipient.
+	RecipientOf func(earner common.Address) common.Address
+}
+
+// AutoClaimer watches an EventIndexer for newly posted DistributionRoots
+// and automatically builds and submits a claim for every configured earner
+// once the root is claimable, instead of requiring an operator to poll and
+// submit claims by hand.
+type AutoClaimer struct {
+	builder *ClaimBuilder
+	indexer *EventIndexer
+	config  AutoClaimerConfig
+	logger  logging.Logger
+}
+
+// NewAutoClaimer returns an AutoClaimer that reacts to roots observed by
+// indexer, building and submitting claims through builder.
+func NewAutoClaimer(builder *ClaimBuilder, indexer *EventIndexer, config AutoClaimerConfig, logger logging.Logger) *AutoClaimer {
+	if config.RecipientOf == nil {
+		config.RecipientOf = func(earner common.Address) common.Address { return earner }
+	}
+	return &AutoClaimer{builder: builder, indexer: indexer, config: config, logger: logger}
+}
+
+// Run consumes indexer.Events() until ctx is canceled, claiming for every
+// configured earner each time a DistributionRootSubmitted event arrives.
+// If the event's ActivatedAt is still in the future, Run waits until then
+// before claiming, and reads no further events while it waits. opts is used for every submitted ProcessClaim transaction; callers
+// running this against multiple earners with different funding accounts
+// should run one AutoClaimer per opts instead.
+func (a *AutoClaimer) Run(ctx context.Context, opts *bind.TransactOpts) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-a.indexer.Events():
+			if !ok {
+				return fmt.Errorf("event indexer channel closed")
+			}
+			if ev.DistributionRootSubmitted == nil {
+				continue
+			}
+			activatesAt := time.Unix(int64(ev.DistributionRootSubmitted.ActivatedAt), 0)
+			if wait := time.Until(activatesAt); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(wait):
+				}
+			}
+			a.claimAll(ctx, opts, ev.DistributionRootSubmitted.Root)
+		}
+	}
+}
+
+func (a *AutoClaimer) claimAll(ctx context.Context, opts *bind.TransactOpts, root [32]byte) {
+	for _, earner := range a.config.Earners {
+		recipient := a.config.RecipientOf(earner)
+		_, tx, err := a.builder.BuildAndSubmitClaim(ctx, earner, recipient, opts, true)
+		if err != nil {
+			a.logger.Error("Auto-claim failed", "earner", earner, "root", root, "error", err)
+			continue
+		}
+		a.logger.Info("Auto-claim submitted", "earner", earner, "recipient", recipient, "txHash", tx.Hash())
+	}
+}
@@ -0,0 +1,21 @@
+package rewards
+
+import (
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// NewClaimBuilderFromCoordinator is a convenience constructor over
+// NewClaimBuilder for the common case of having a single, fully-bound
+// *contractIRewardsCoordinator.ContractIRewardsCoordinator (as returned by
+// contractIRewardsCoordinator.NewContractIRewardsCoordinator) rather than
+// separate caller/transactor instances. It lets callers go from "I have the
+// RewardsCoordinator contract binding" to "I can build and submit claims"
+// without wiring the reader/writer interfaces themselves.
+func NewClaimBuilderFromCoordinator(
+	coordinator *contractIRewardsCoordinator.ContractIRewardsCoordinator,
+	leaves LeafSource,
+	logger logging.Logger,
+) *ClaimBuilder {
+	return NewClaimBuilder(&coordinator.ContractIRewardsCoordinatorCaller, &coordinator.ContractIRewardsCoordinatorTransactor, leaves, logger)
+}
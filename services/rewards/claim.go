@@ -0,0 +1,105 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// ClaimWriter is the subset of the RewardsCoordinator's write surface used
+// to submit a claim, satisfied by
+// *contractIRewardsCoordinator.ContractIRewardsCoordinatorTransactor.
+type ClaimWriter interface {
+	ProcessClaim(opts *bind.TransactOpts, claim contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error)
+}
+
+// LeafSource fetches the leaves of a posted DistributionRoot so that a
+// ClaimBuilder can reconstruct the tree and prove a specific earner without
+// recomputing the entire off-chain rewards calculation itself. See the
+// proofstore subpackage for concrete implementations.
+type LeafSource interface {
+	GetEarnerLeaves(ctx context.Context, root [32]byte) (EarnerLeaves, error)
+}
+
+// ClaimBuilder reconstructs RewardsMerkleClaim values for a posted
+// DistributionRoot and, optionally, submits them via ProcessClaim.
+type ClaimBuilder struct {
+	reader DistributionRootsReader
+	writer ClaimWriter
+	leaves LeafSource
+	logger logging.Logger
+}
+
+// NewClaimBuilder returns a ClaimBuilder that resolves the claimable root via
+// reader, fetches its leaves via leaves, and, when asked to broadcast,
+// submits through writer.
+func NewClaimBuilder(reader DistributionRootsReader, writer ClaimWriter, leaves LeafSource, logger logging.Logger) *ClaimBuilder {
+	return &ClaimBuilder{reader: reader, writer: writer, leaves: leaves, logger: logger}
+}
+
+// BuildClaim reconstructs the Merkle tree for the latest claimable
+// DistributionRoot and returns a fully populated claim for earner, without
+// submitting anything on-chain.
+func (b *ClaimBuilder) BuildClaim(ctx context.Context, earner common.Address) (contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, error) {
+	root, rootIndex, err := LatestClaimableRoot(ctx, b.reader)
+	if err != nil {
+		return contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{}, fmt.Errorf("error resolving latest claimable root: %w", err)
+	}
+
+	entries, err := b.leaves.GetEarnerLeaves(ctx, root.Root)
+	if err != nil {
+		return contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{}, fmt.Errorf("error fetching earner leaves for root %x: %w", root.Root, err)
+	}
+
+	tree, err := BuildEarnerTree(entries)
+	if err != nil {
+		return contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{}, fmt.Errorf("error rebuilding earner tree: %w", err)
+	}
+	if tree.Root() != root.Root {
+		return contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{}, fmt.Errorf(
+			"reconstructed root %x does not match posted root %x; leaf source is stale or incomplete",
+			tree.Root(), root.Root,
+		)
+	}
+
+	claim, err := tree.ProveEarner(earner)
+	if err != nil {
+		return contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{}, err
+	}
+	claim.RootIndex = rootIndex
+	return claim, nil
+}
+
+// BuildAndSubmitClaim builds a claim for earner against the latest claimable
+// DistributionRoot and, when broadcast is true, submits it via ProcessClaim
+// with recipient as the payout address. When broadcast is false it only
+// returns the populated claim, mirroring a dry-run of the eigenlayer-cli's
+// claim flow.
+func (b *ClaimBuilder) BuildAndSubmitClaim(
+	ctx context.Context,
+	earner common.Address,
+	recipient common.Address,
+	opts *bind.TransactOpts,
+	broadcast bool,
+) (contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, *types.Transaction, error) {
+	claim, err := b.BuildClaim(ctx, earner)
+	if err != nil {
+		return claim, nil, err
+	}
+	if !broadcast {
+		return claim, nil, nil
+	}
+
+	b.logger.Info("Submitting rewards claim", "earner", earner, "recipient", recipient, "rootIndex", claim.RootIndex)
+	tx, err := b.writer.ProcessClaim(opts, claim, recipient)
+	if err != nil {
+		return claim, nil, fmt.Errorf("error submitting ProcessClaim: %w", err)
+	}
+	return claim, tx, nil
+}
@@ -0,0 +1,62 @@
+package rewards
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// ClaimResult is the outcome of building (and optionally submitting) a
+// claim for a single earner.
+type ClaimResult struct {
+	Earner common.Address
+	Claim  contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+	Tx     *types.Transaction
+	Err    error
+}
+
+// RewardsClaimer is a thin, user-facing façade over ClaimBuilder: where
+// ClaimBuilder handles a single earner, RewardsClaimer fans a claim-and-
+// submit request out over many earners and reports per-earner success or
+// failure, which is the shape most AVS/operator tooling actually wants
+// ("claim for everyone who's owed something").
+type RewardsClaimer struct {
+	builder *ClaimBuilder
+	logger  logging.Logger
+}
+
+// NewRewardsClaimer returns a RewardsClaimer backed by builder.
+func NewRewardsClaimer(builder *ClaimBuilder, logger logging.Logger) *RewardsClaimer {
+	return &RewardsClaimer{builder: builder, logger: logger}
+}
+
+// ClaimFor builds and, if broadcast is true, submits a claim for each
+// earner, continuing past individual failures so one earner's stale leaf
+// data doesn't block the rest of the batch. recipientOf maps an earner to
+// its payout recipient; if nil, each earner is its own recipient.
+func (c *RewardsClaimer) ClaimFor(
+	ctx context.Context,
+	earners []common.Address,
+	recipientOf func(common.Address) common.Address,
+	opts *bind.TransactOpts,
+	broadcast bool,
+) []ClaimResult {
+	if recipientOf == nil {
+		recipientOf = func(e common.Address) common.Address { return e }
+	}
+	results := make([]ClaimResult, len(earners))
+	for i, earner := range earners {
+		recipient := recipientOf(earner)
+		claim, tx, err := c.builder.BuildAndSubmitClaim(ctx, earner, recipient, opts, broadcast)
+		if err != nil {
+			c.logger.Error("Failed to build/submit claim", "earner", earner, "error", err)
+		}
+		results[i] = ClaimResult{Earner: earner, Claim: claim, Tx: tx, Err: err}
+	}
+	return results
+}
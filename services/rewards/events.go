@@ -0,0 +1,345 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	rewardscoordinator "github.com/Layr-Labs/eigensdk-go/chainio/subscriber/rewardscoordinator"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// eventFilterer is the subset of the RewardsCoordinator binding
+// rewardscoordinator.Subscriber needs, redeclared here (identical to that
+// package's own unexported eventFilterer) so NewEventIndexer can name it
+// without depending on that package's internals - the same pattern
+// chainio/rewardsstream uses.
+type eventFilterer interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySetIterator, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner, oldClaimer, claimer []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSetIterator, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorClaimerForSet, earner, oldClaimer, claimer []common.Address) (event.Subscription, error)
+
+	FilterDefaultOperatorSplitBipsSet(opts *bind.FilterOpts) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSetIterator, error)
+	WatchDefaultOperatorSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDefaultOperatorSplitBipsSet) (event.Subscription, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabledIterator, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	FilterOperatorAVSSplitBipsSet(opts *bind.FilterOpts, caller, operator, avs []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSetIterator, error)
+	WatchOperatorAVSSplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorAVSSplitBipsSet, caller, operator, avs []common.Address) (event.Subscription, error)
+
+	FilterOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.FilterOpts, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreatedIterator, error)
+	WatchOperatorDirectedAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated, caller, avs []common.Address, operatorDirectedRewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterOperatorPISplitBipsSet(opts *bind.FilterOpts, caller, operator []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSetIterator, error)
+	WatchOperatorPISplitBipsSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorOperatorPISplitBipsSet, caller, operator []common.Address) (event.Subscription, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner, claimer, recipient []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimedIterator, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsClaimed, earner, claimer, recipient []common.Address) (event.Subscription, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue, newValue []bool) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsSubmissionForAllEarnersCreated(opts *bind.FilterOpts, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreatedIterator, error)
+	WatchRewardsSubmissionForAllEarnersCreated(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsSubmissionForAllEarnersCreated, tokenHopper []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater, newRewardsUpdater []common.Address) (*contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *contractIRewardsCoordinator.ContractIRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+// DistributionRootSubmittedEvent is a normalized view of the contract's
+// DistributionRootSubmitted log.
+type DistributionRootSubmittedEvent struct {
+	RootIndex                      uint32
+	Root                           [32]byte
+	RewardsCalculationEndTimestamp uint32
+	ActivatedAt                    uint32
+	BlockNumber                    uint64
+	BlockHash                      common.Hash
+	LogIndex                       uint
+	TxHash                         common.Hash
+}
+
+// DistributionRootDisabledEvent is a normalized view of the contract's
+// DistributionRootDisabled log.
+type DistributionRootDisabledEvent struct {
+	RootIndex   uint32
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// OperatorDirectedAVSRewardsSubmissionCreatedEvent is a normalized view of
+// the contract's OperatorDirectedAVSRewardsSubmissionCreated log.
+type OperatorDirectedAVSRewardsSubmissionCreatedEvent struct {
+	Caller      common.Address
+	AVS         common.Address
+	Submission  contractIRewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// RewardsClaimedEvent is a normalized view of the contract's RewardsClaimed
+// log.
+type RewardsClaimedEvent struct {
+	Root          [32]byte
+	Earner        common.Address
+	Claimer       common.Address
+	Recipient     common.Address
+	Token         common.Address
+	ClaimedAmount *big.Int
+	BlockNumber   uint64
+	BlockHash     common.Hash
+	LogIndex      uint
+	TxHash        common.Hash
+}
+
+// ClaimerForSetEvent is a normalized view of the contract's ClaimerForSet
+// log.
+type ClaimerForSetEvent struct {
+	Earner      common.Address
+	OldClaimer  common.Address
+	Claimer     common.Address
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// EventIndexerEvent bundles one instance of each normalized event type so a
+// single push channel can carry all of them; exactly one field is non-nil
+// per delivered value.
+type EventIndexerEvent struct {
+	DistributionRootSubmitted                   *DistributionRootSubmittedEvent
+	DistributionRootDisabled                    *DistributionRootDisabledEvent
+	OperatorDirectedAVSRewardsSubmissionCreated *OperatorDirectedAVSRewardsSubmissionCreatedEvent
+	RewardsClaimed                              *RewardsClaimedEvent
+	ClaimerForSet                               *ClaimerForSetEvent
+}
+
+// EventIndexerConfig controls the underlying rewardscoordinator.Subscriber.
+type EventIndexerConfig struct {
+	// StartBlock is the block Run backfills from.
+	StartBlock uint64
+	Subscriber rewardscoordinator.Config
+}
+
+// EventIndexer normalizes the five rewards-relevant RewardsCoordinator
+// events out of a rewardscoordinator.Subscriber's unified event stream,
+// and exposes both a push channel (Events) and a pull API over everything
+// it has seen so far. All backfill chunking, reorg detection and
+// resubscription is the Subscriber's job, not this package's - Run only
+// translates and caches its output.
+type EventIndexer struct {
+	sub    *rewardscoordinator.Subscriber
+	config EventIndexerConfig
+	logger logging.Logger
+
+	events chan EventIndexerEvent
+
+	mu     sync.RWMutex
+	roots  []DistributionRootSubmittedEvent
+	claims map[common.Address][]RewardsClaimedEvent
+}
+
+// NewEventIndexer returns an EventIndexer backed by filterer and headFunc,
+// which are passed straight through to a new rewardscoordinator.Subscriber.
+func NewEventIndexer(filterer eventFilterer, headFunc func(ctx context.Context) (uint64, error), config EventIndexerConfig, logger logging.Logger) *EventIndexer {
+	return &EventIndexer{
+		sub:    rewardscoordinator.New(filterer, headFunc, config.Subscriber, logger),
+		config: config,
+		logger: logger,
+		events: make(chan EventIndexerEvent, 256),
+		claims: make(map[common.Address][]RewardsClaimedEvent),
+	}
+}
+
+// Events returns the channel new events are pushed to as they're observed,
+// whether from backfill or a live subscription.
+func (idx *EventIndexer) Events() <-chan EventIndexerEvent {
+	return idx.events
+}
+
+// ListRootsBetween returns every DistributionRootSubmitted event observed
+// with a block number in [from, to].
+func (idx *EventIndexer) ListRootsBetween(from, to uint64) []DistributionRootSubmittedEvent {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	var out []DistributionRootSubmittedEvent
+	for _, r := range idx.roots {
+		if r.BlockNumber >= from && r.BlockNumber <= to {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// ClaimHistoryFor returns every RewardsClaimed event observed for earner, in
+// the order they were seen.
+func (idx *EventIndexer) ClaimHistoryFor(earner common.Address) []RewardsClaimedEvent {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	out := make([]RewardsClaimedEvent, len(idx.claims[earner]))
+	copy(out, idx.claims[earner])
+	return out
+}
+
+func (idx *EventIndexer) recordRoot(e DistributionRootSubmittedEvent) {
+	idx.mu.Lock()
+	idx.roots = append(idx.roots, e)
+	sort.Slice(idx.roots, func(i, j int) bool { return idx.roots[i].BlockNumber < idx.roots[j].BlockNumber })
+	idx.mu.Unlock()
+}
+
+func (idx *EventIndexer) recordClaim(e RewardsClaimedEvent) {
+	idx.mu.Lock()
+	idx.claims[e.Earner] = append(idx.claims[e.Earner], e)
+	idx.mu.Unlock()
+}
+
+// Run backfills from config.StartBlock and then watches for new events
+// until ctx is canceled, normalizing and recording every
+// DistributionRootSubmitted/RewardsClaimed event and pushing every
+// normalized event (of the five kinds this package cares about) to
+// Events(). A reorged-out event (Reverted true) is dropped from the
+// root/claim history it was previously recorded into rather than
+// forwarded, since the Subscriber only marks an event Reverted after
+// already having delivered it once with Reverted false.
+func (idx *EventIndexer) Run(ctx context.Context) error {
+	raw := make(chan rewardscoordinator.RewardsCoordinatorEvent)
+	sub, err := idx.sub.Subscribe(ctx, idx.config.StartBlock, raw)
+	if err != nil {
+		return fmt.Errorf("error subscribing to RewardsCoordinator events: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			return fmt.Errorf("RewardsCoordinator subscription error: %w", err)
+		case ev, ok := <-raw:
+			if !ok {
+				return nil
+			}
+			idx.handle(ev)
+		}
+	}
+}
+
+func (idx *EventIndexer) handle(ev rewardscoordinator.RewardsCoordinatorEvent) {
+	switch ev.Kind {
+	case rewardscoordinator.KindDistributionRootSubmitted:
+		if ev.Reverted {
+			idx.removeRoot(ev.BlockHash, ev.LogIndex)
+			return
+		}
+		e := DistributionRootSubmittedEvent{
+			RootIndex:                      ev.DistributionRootSubmitted.RootIndex,
+			Root:                           ev.DistributionRootSubmitted.Root,
+			RewardsCalculationEndTimestamp: ev.DistributionRootSubmitted.RewardsCalculationEndTimestamp,
+			ActivatedAt:                    ev.DistributionRootSubmitted.ActivatedAt,
+			BlockNumber:                    ev.BlockNumber,
+			BlockHash:                      ev.BlockHash,
+			LogIndex:                       ev.LogIndex,
+			TxHash:                         ev.DistributionRootSubmitted.Raw.TxHash,
+		}
+		idx.recordRoot(e)
+		idx.events <- EventIndexerEvent{DistributionRootSubmitted: &e}
+	case rewardscoordinator.KindDistributionRootDisabled:
+		if ev.Reverted {
+			return
+		}
+		e := DistributionRootDisabledEvent{
+			RootIndex:   ev.DistributionRootDisabled.RootIndex,
+			BlockNumber: ev.BlockNumber,
+			TxHash:      ev.DistributionRootDisabled.Raw.TxHash,
+		}
+		idx.events <- EventIndexerEvent{DistributionRootDisabled: &e}
+	case rewardscoordinator.KindOperatorDirectedAVSRewardsSubmissionCreated:
+		if ev.Reverted {
+			return
+		}
+		e := OperatorDirectedAVSRewardsSubmissionCreatedEvent{
+			Caller:      ev.OperatorDirectedAVSRewardsSubmissionCreated.Caller,
+			AVS:         ev.OperatorDirectedAVSRewardsSubmissionCreated.Avs,
+			Submission:  ev.OperatorDirectedAVSRewardsSubmissionCreated.OperatorDirectedRewardsSubmission,
+			BlockNumber: ev.BlockNumber,
+			TxHash:      ev.OperatorDirectedAVSRewardsSubmissionCreated.Raw.TxHash,
+		}
+		idx.events <- EventIndexerEvent{OperatorDirectedAVSRewardsSubmissionCreated: &e}
+	case rewardscoordinator.KindRewardsClaimed:
+		if ev.Reverted {
+			idx.removeClaim(ev.BlockHash, ev.LogIndex)
+			return
+		}
+		e := RewardsClaimedEvent{
+			Root:          ev.RewardsClaimed.Root,
+			Earner:        ev.RewardsClaimed.Earner,
+			Claimer:       ev.RewardsClaimed.Claimer,
+			Recipient:     ev.RewardsClaimed.Recipient,
+			Token:         ev.RewardsClaimed.Token,
+			ClaimedAmount: ev.RewardsClaimed.ClaimedAmount,
+			BlockNumber:   ev.BlockNumber,
+			BlockHash:     ev.BlockHash,
+			LogIndex:      ev.LogIndex,
+			TxHash:        ev.RewardsClaimed.Raw.TxHash,
+		}
+		idx.recordClaim(e)
+		idx.events <- EventIndexerEvent{RewardsClaimed: &e}
+	case rewardscoordinator.KindClaimerForSet:
+		if ev.Reverted {
+			return
+		}
+		e := ClaimerForSetEvent{
+			Earner:      ev.ClaimerForSet.Earner,
+			OldClaimer:  ev.ClaimerForSet.OldClaimer,
+			Claimer:     ev.ClaimerForSet.Claimer,
+			BlockNumber: ev.BlockNumber,
+			TxHash:      ev.ClaimerForSet.Raw.TxHash,
+		}
+		idx.events <- EventIndexerEvent{ClaimerForSet: &e}
+	}
+}
+
+func (idx *EventIndexer) removeRoot(blockHash common.Hash, logIndex uint) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	kept := idx.roots[:0]
+	for _, r := range idx.roots {
+		if r.BlockHash != blockHash || r.LogIndex != logIndex {
+			kept = append(kept, r)
+		}
+	}
+	idx.roots = kept
+}
+
+func (idx *EventIndexer) removeClaim(blockHash common.Hash, logIndex uint) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for earner, claims := range idx.claims {
+		kept := claims[:0]
+		for _, c := range claims {
+			if c.BlockHash != blockHash || c.LogIndex != logIndex {
+				kept = append(kept, c)
+			}
+		}
+		idx.claims[earner] = kept
+	}
+}
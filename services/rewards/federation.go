@@ -0,0 +1,84 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ChainClaimer pairs a chain ID with the ClaimBuilder for the
+// RewardsCoordinator deployed on that chain.
+type ChainClaimer struct {
+	ChainID *big.Int
+	Builder *ClaimBuilder
+}
+
+// Federation fans claim requests out across multiple RewardsCoordinator
+// deployments (e.g. an AVS that's been redeployed to several L2s), so
+// callers don't need to track which chain a given earner's claim lives on
+// themselves.
+type Federation struct {
+	claimers map[string]*ChainClaimer
+}
+
+// NewFederation returns an empty Federation; use Add to register each
+// chain's ClaimBuilder.
+func NewFederation() *Federation {
+	return &Federation{claimers: make(map[string]*ChainClaimer)}
+}
+
+// Add registers builder as the claimer for chainID, replacing any existing
+// registration for that chain.
+func (f *Federation) Add(chainID *big.Int, builder *ClaimBuilder) {
+	f.claimers[chainID.String()] = &ChainClaimer{ChainID: chainID, Builder: builder}
+}
+
+// ChainIDs returns every chain ID currently registered, in no particular
+// order.
+func (f *Federation) ChainIDs() []*big.Int {
+	out := make([]*big.Int, 0, len(f.claimers))
+	for _, c := range f.claimers {
+		out = append(out, c.ChainID)
+	}
+	return out
+}
+
+// ClaimOn builds and, if broadcast is true, submits a claim for earner on
+// the RewardsCoordinator deployed on chainID.
+func (f *Federation) ClaimOn(
+	ctx context.Context,
+	chainID *big.Int,
+	earner common.Address,
+	recipient common.Address,
+	opts *bind.TransactOpts,
+	broadcast bool,
+) (ClaimResult, error) {
+	c, ok := f.claimers[chainID.String()]
+	if !ok {
+		return ClaimResult{}, fmt.Errorf("no RewardsCoordinator registered for chain %s", chainID)
+	}
+	claim, tx, err := c.Builder.BuildAndSubmitClaim(ctx, earner, recipient, opts, broadcast)
+	return ClaimResult{Earner: earner, Claim: claim, Tx: tx, Err: err}, err
+}
+
+// ClaimEverywhere attempts ClaimOn for earner against every registered
+// chain, returning one result per chain, keyed by the chain ID's decimal
+// string, so a caller can see which deployments actually owed the earner
+// something.
+func (f *Federation) ClaimEverywhere(
+	ctx context.Context,
+	earner common.Address,
+	recipient common.Address,
+	optsFor func(chainID *big.Int) *bind.TransactOpts,
+	broadcast bool,
+) map[string]ClaimResult {
+	out := make(map[string]ClaimResult, len(f.claimers))
+	for key, c := range f.claimers {
+		claim, tx, err := c.Builder.BuildAndSubmitClaim(ctx, earner, recipient, optsFor(c.ChainID), broadcast)
+		out[key] = ClaimResult{Earner: earner, Claim: claim, Tx: tx, Err: err}
+	}
+	return out
+}
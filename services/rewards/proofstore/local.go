@@ -0,0 +1,102 @@
+package proofstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/services/rewards"
+)
+
+// localRootFile is the on-disk layout a LocalStore reads, one file per
+// posted root, named "<root-hex>.json".
+type localRootFile struct {
+	Earners map[common.Address][]localEarning `json:"earners"`
+}
+
+type localEarning struct {
+	Token              common.Address `json:"token"`
+	CumulativeEarnings string         `json:"cumulativeEarnings"`
+}
+
+// LocalStore reads proof data from JSON fixtures on the local filesystem,
+// intended for tests and local development where standing up a hosted proof
+// service isn't worthwhile.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore returns a LocalStore rooted at dir. dir should contain one
+// "<root-hex>.json" file per DistributionRoot and a "latest" file holding
+// the hex root GetLatestClaimableRoot returns. GetLatestClaimableRoot does
+// not use its timestamp argument; it returns that same root for every
+// timestamp.
+func NewLocalStore(dir string) *LocalStore {
+	return &LocalStore{dir: dir}
+}
+
+func (s *LocalStore) GetLatestClaimableRoot(ctx context.Context, timestamp uint32) ([32]byte, error) {
+	b, err := os.ReadFile(filepath.Join(s.dir, "latest"))
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("error reading latest root pointer: %w", err)
+	}
+	return decodeRoot(string(b))
+}
+
+func (s *LocalStore) readRootFile(root [32]byte) (localRootFile, error) {
+	var out localRootFile
+	path := filepath.Join(s.dir, fmt.Sprintf("%x.json", root))
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return out, fmt.Errorf("error reading proof fixture %s: %w", path, err)
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return out, fmt.Errorf("error parsing proof fixture %s: %w", path, err)
+	}
+	return out, nil
+}
+
+func (s *LocalStore) GetEarnerLeaves(ctx context.Context, root [32]byte) (rewards.EarnerLeaves, error) {
+	file, err := s.readRootFile(root)
+	if err != nil {
+		return nil, err
+	}
+	out := make(rewards.EarnerLeaves, len(file.Earners))
+	for earner, entries := range file.Earners {
+		earnings, err := toEarnings(entries)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding earnings for earner %s: %w", earner, err)
+		}
+		out[earner] = earnings
+	}
+	return out, nil
+}
+
+func (s *LocalStore) GetTokenLeaves(ctx context.Context, root [32]byte, earner common.Address) ([]rewards.Earning, error) {
+	file, err := s.readRootFile(root)
+	if err != nil {
+		return nil, err
+	}
+	entries, ok := file.Earners[earner]
+	if !ok {
+		return nil, fmt.Errorf("earner %s has no leaves under root %x", earner, root)
+	}
+	return toEarnings(entries)
+}
+
+func toEarnings(entries []localEarning) ([]rewards.Earning, error) {
+	out := make([]rewards.Earning, len(entries))
+	for i, e := range entries {
+		amount, ok := new(big.Int).SetString(e.CumulativeEarnings, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid cumulative earnings %q for token %s", e.CumulativeEarnings, e.Token)
+		}
+		out[i] = rewards.Earning{Token: e.Token, CumulativeEarnings: amount}
+	}
+	return out, nil
+}
@@ -0,0 +1,53 @@
+package proofstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigensdk-go/services/rewards"
+)
+
+// OnChainStore is the fallback for networks where no hosted proof service
+// is available. It only resolves the latest claimable root, through view
+// calls on caller (rewards.LatestClaimableRoot), and ignores the timestamp
+// argument. It reads no event history and does not reconstruct leaves:
+// GetEarnerLeaves and GetTokenLeaves always return an error, since
+// computing EarnerLeaves from raw submissions requires running the same
+// off-chain rewards calculation the RewardsCoordinator's offline
+// calculator does. filterer is stored but not read. Callers that need
+// full leaf reconstruction without a hosted service should pair OnChainStore
+// with a LocalStore populated from an independently-run calculation.
+type OnChainStore struct {
+	filterer *contractIRewardsCoordinator.ContractIRewardsCoordinatorFilterer
+	caller   *contractIRewardsCoordinator.ContractIRewardsCoordinatorCaller
+}
+
+// NewOnChainStore returns an OnChainStore backed by filterer and caller,
+// both obtainable from contractIRewardsCoordinator.NewContractIRewardsCoordinator.
+func NewOnChainStore(
+	filterer *contractIRewardsCoordinator.ContractIRewardsCoordinatorFilterer,
+	caller *contractIRewardsCoordinator.ContractIRewardsCoordinatorCaller,
+) *OnChainStore {
+	return &OnChainStore{filterer: filterer, caller: caller}
+}
+
+func (s *OnChainStore) GetLatestClaimableRoot(ctx context.Context, timestamp uint32) ([32]byte, error) {
+	root, _, err := rewards.LatestClaimableRoot(ctx, s.caller)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return root.Root, nil
+}
+
+func (s *OnChainStore) GetEarnerLeaves(ctx context.Context, root [32]byte) (rewards.EarnerLeaves, error) {
+	return nil, fmt.Errorf("OnChainStore cannot reconstruct earner leaves for root %x without an off-chain rewards calculation; use a LocalStore or RemoteStore", root)
+}
+
+func (s *OnChainStore) GetTokenLeaves(ctx context.Context, root [32]byte, earner common.Address) ([]rewards.Earning, error) {
+	return nil, fmt.Errorf("OnChainStore cannot reconstruct token leaves for earner %s under root %x without an off-chain rewards calculation; use a LocalStore or RemoteStore", earner, root)
+}
+
+var _ ProofStore = (*OnChainStore)(nil)
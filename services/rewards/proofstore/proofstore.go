@@ -0,0 +1,33 @@
+// Package proofstore provides pluggable backends for fetching the raw
+// earner/token leaves behind a posted RewardsCoordinator DistributionRoot,
+// so that the rewards package's claim builder doesn't need to know whether
+// those leaves come from a hosted proof service, a local file, or the chain
+// itself.
+package proofstore
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/services/rewards"
+)
+
+// ProofStore fetches the data needed to reconstruct and prove against a
+// posted DistributionRoot. GetLatestClaimableRoot resolves which root is
+// currently claimable for a given cutoff timestamp; GetEarnerLeaves and
+// GetTokenLeaves fetch the leaves that hash to that root.
+type ProofStore interface {
+	// GetLatestClaimableRoot returns the DistributionRoot (as a 32-byte
+	// hash) that was active at timestamp, or the latest one if timestamp
+	// is zero.
+	GetLatestClaimableRoot(ctx context.Context, timestamp uint32) ([32]byte, error)
+
+	// GetEarnerLeaves returns every earner's token-tree leaves posted
+	// under root, keyed by earner address.
+	GetEarnerLeaves(ctx context.Context, root [32]byte) (rewards.EarnerLeaves, error)
+
+	// GetTokenLeaves returns a single earner's token-tree leaves posted
+	// under root.
+	GetTokenLeaves(ctx context.Context, root [32]byte, earner common.Address) ([]rewards.Earning, error)
+}
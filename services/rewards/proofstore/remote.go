@@ -0,0 +1,167 @@
+package proofstore
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/services/rewards"
+)
+
+// Network identifies a deployment the way the eigenlayer-cli keys its
+// ProofStoreBaseURL configuration (e.g. "mainnet", "holesky").
+type Network string
+
+// RemoteStore fetches proofs from an HTTP(S)/S3-style service, one base URL
+// per Network, matching how the eigenlayer-cli locates hosted rewards
+// proofs.
+type RemoteStore struct {
+	baseURLs map[Network]string
+	network  Network
+	client   *http.Client
+}
+
+// NewRemoteStore returns a RemoteStore that issues requests against
+// baseURLs[network]. The caller supplies one base URL per network so a
+// single binary can be pointed at a different environment without a code
+// change.
+func NewRemoteStore(baseURLs map[Network]string, network Network, client *http.Client) *RemoteStore {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &RemoteStore{baseURLs: baseURLs, network: network, client: client}
+}
+
+func (s *RemoteStore) baseURL() (string, error) {
+	base, ok := s.baseURLs[s.network]
+	if !ok {
+		return "", fmt.Errorf("no proof store base URL configured for network %q", s.network)
+	}
+	return base, nil
+}
+
+type latestRootResponse struct {
+	Root string `json:"root"`
+}
+
+// GetLatestClaimableRoot calls GET {base}/roots/latest?timestamp={timestamp}.
+func (s *RemoteStore) GetLatestClaimableRoot(ctx context.Context, timestamp uint32) ([32]byte, error) {
+	var out [32]byte
+	base, err := s.baseURL()
+	if err != nil {
+		return out, err
+	}
+	u := fmt.Sprintf("%s/roots/latest?timestamp=%d", base, timestamp)
+	var resp latestRootResponse
+	if err := s.getJSON(ctx, u, &resp); err != nil {
+		return out, err
+	}
+	return decodeRoot(resp.Root)
+}
+
+type earnerLeafResponse struct {
+	Earner string `json:"earner"`
+	Tokens []struct {
+		Token              string `json:"token"`
+		CumulativeEarnings string `json:"cumulativeEarnings"`
+	} `json:"tokens"`
+}
+
+// GetEarnerLeaves calls GET {base}/roots/{root}/earners.
+func (s *RemoteStore) GetEarnerLeaves(ctx context.Context, root [32]byte) (rewards.EarnerLeaves, error) {
+	base, err := s.baseURL()
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/roots/%s/earners", base, hex.EncodeToString(root[:]))
+	var resp []earnerLeafResponse
+	if err := s.getJSON(ctx, u, &resp); err != nil {
+		return nil, err
+	}
+	out := make(rewards.EarnerLeaves, len(resp))
+	for _, e := range resp {
+		earnings, err := decodeEarnings(e.Tokens)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding earnings for earner %s: %w", e.Earner, err)
+		}
+		out[common.HexToAddress(e.Earner)] = earnings
+	}
+	return out, nil
+}
+
+// GetTokenLeaves calls GET {base}/roots/{root}/earners/{earner}.
+func (s *RemoteStore) GetTokenLeaves(ctx context.Context, root [32]byte, earner common.Address) ([]rewards.Earning, error) {
+	base, err := s.baseURL()
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/roots/%s/earners/%s", base, hex.EncodeToString(root[:]), earner.Hex())
+	var resp earnerLeafResponse
+	if err := s.getJSON(ctx, u, &resp); err != nil {
+		return nil, err
+	}
+	return decodeEarnings(resp.Tokens)
+}
+
+func (s *RemoteStore) getJSON(ctx context.Context, rawURL string, out interface{}) error {
+	if _, err := url.Parse(rawURL); err != nil {
+		return fmt.Errorf("invalid proof store URL %q: %w", rawURL, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error requesting %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("proof store request to %s returned status %d", rawURL, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func decodeRoot(s string) ([32]byte, error) {
+	var out [32]byte
+	b, err := hex.DecodeString(trimHexPrefix(s))
+	if err != nil {
+		return out, fmt.Errorf("invalid root hex %q: %w", s, err)
+	}
+	if len(b) != 32 {
+		return out, fmt.Errorf("root %q is not 32 bytes", s)
+	}
+	copy(out[:], b)
+	return out, nil
+}
+
+func decodeEarnings(tokens []struct {
+	Token              string `json:"token"`
+	CumulativeEarnings string `json:"cumulativeEarnings"`
+}) ([]rewards.Earning, error) {
+	out := make([]rewards.Earning, len(tokens))
+	for i, t := range tokens {
+		amount, ok := new(big.Int).SetString(t.CumulativeEarnings, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid cumulative earnings %q for token %s", t.CumulativeEarnings, t.Token)
+		}
+		out[i] = rewards.Earning{
+			Token:              common.HexToAddress(t.Token),
+			CumulativeEarnings: amount,
+		}
+	}
+	return out, nil
+}
+
+func trimHexPrefix(s string) string {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+	return s
+}
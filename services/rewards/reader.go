@@ -0,0 +1,58 @@
+// Package rewards provides a high-level client for computing and submitting
+// RewardsCoordinator Merkle claims, without requiring callers to hand-roll
+// the two-level earner/token tree that the contract expects.
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// DistributionRootsReader is the subset of the RewardsCoordinator's view
+// functions needed to locate and fetch a posted DistributionRoot. It is
+// satisfied by *contractIRewardsCoordinator.ContractIRewardsCoordinatorCaller,
+// and is defined separately so callers can supply a mock in tests.
+type DistributionRootsReader interface {
+	GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error)
+	GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (contractIRewardsCoordinator.IRewardsCoordinatorDistributionRoot, error)
+	GetCurrentClaimableDistributionRoot(opts *bind.CallOpts) (contractIRewardsCoordinator.IRewardsCoordinatorDistributionRoot, error)
+	GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error)
+	CheckClaim(opts *bind.CallOpts, claim contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim) (bool, error)
+}
+
+// LatestClaimableRoot returns the most recently activated, non-disabled
+// DistributionRoot along with its index, scanning backwards from the end of
+// the roots array so that a just-disabled root doesn't get returned.
+func LatestClaimableRoot(
+	ctx context.Context,
+	reader DistributionRootsReader,
+) (root contractIRewardsCoordinator.IRewardsCoordinatorDistributionRoot, rootIndex uint32, err error) {
+	length, err := reader.GetDistributionRootsLength(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return root, 0, fmt.Errorf("error getting distribution roots length: %w", err)
+	}
+	now := uint32(time.Now().Unix())
+	for i := new(big.Int).Sub(length, big.NewInt(1)); i.Sign() >= 0; i.Sub(i, big.NewInt(1)) {
+		candidate, err := reader.GetDistributionRootAtIndex(&bind.CallOpts{Context: ctx}, i)
+		if err != nil {
+			return root, 0, fmt.Errorf("error getting distribution root at index %s: %w", i, err)
+		}
+		if !candidate.Disabled && candidate.ActivatedAt <= now {
+			return candidate, uint32(i.Uint64()), nil
+		}
+	}
+	return root, 0, ErrNoClaimableRoot
+}
+
+// rootIndexOf is a small helper that resolves the RootIndex for a root
+// whose hash is already known. Nothing in this package calls it; the claim
+// builder takes the index from LatestClaimableRoot.
+func rootIndexOf(ctx context.Context, reader DistributionRootsReader, root [32]byte) (uint32, error) {
+	return reader.GetRootIndexFromHash(&bind.CallOpts{Context: ctx}, root)
+}
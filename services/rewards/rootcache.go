@@ -0,0 +1,48 @@
+package rewards
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RootAsOf returns the DistributionRoot whose
+// RewardsCalculationEndTimestamp is the largest one not exceeding
+// timestamp, i.e. the root that was claimable as of that cutoff. It
+// searches the in-memory cache built up by Run rather than
+// making a chain call, so it only sees roots the indexer has already
+// observed.
+func (idx *EventIndexer) RootAsOf(timestamp uint32) (DistributionRootSubmittedEvent, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	// idx.roots is kept sorted by BlockNumber, not by
+	// RewardsCalculationEndTimestamp; the two are monotonic with each
+	// other since roots are always submitted in order, but sort a copy
+	// by timestamp defensively rather than assume that invariant here.
+	sorted := append([]DistributionRootSubmittedEvent(nil), idx.roots...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].RewardsCalculationEndTimestamp < sorted[j].RewardsCalculationEndTimestamp
+	})
+
+	var best *DistributionRootSubmittedEvent
+	for i := range sorted {
+		if sorted[i].RewardsCalculationEndTimestamp > timestamp {
+			break
+		}
+		best = &sorted[i]
+	}
+	if best == nil {
+		return DistributionRootSubmittedEvent{}, fmt.Errorf("no cached distribution root with calculation end timestamp <= %d", timestamp)
+	}
+	return *best, nil
+}
+
+// AllRoots returns every DistributionRootSubmitted event currently cached,
+// ordered by block number.
+func (idx *EventIndexer) AllRoots() []DistributionRootSubmittedEvent {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	out := make([]DistributionRootSubmittedEvent, len(idx.roots))
+	copy(out, idx.roots)
+	return out
+}
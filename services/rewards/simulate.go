@@ -0,0 +1,71 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// gasEstimator is the subset of bind.ContractBackend needed to estimate the
+// gas a ProcessClaim call would cost without sending it.
+type gasEstimator interface {
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
+// SimulationResult is the outcome of dry-running a claim: whether the
+// contract's own CheckClaim would accept it, and (if a gas estimator was
+// configured) how much gas ProcessClaim is expected to cost.
+type SimulationResult struct {
+	Claim    contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+	Valid    bool
+	GasLimit uint64
+}
+
+// Simulate builds a claim for earner exactly as BuildClaim does, then calls
+// the contract's own CheckClaim view function to confirm it would be
+// accepted, and - if ethClient is non-nil - estimates the gas a ProcessClaim
+// call for it would cost. Nothing is broadcast.
+func (b *ClaimBuilder) Simulate(
+	ctx context.Context,
+	earner common.Address,
+	recipient common.Address,
+	from common.Address,
+	coordinatorAddress common.Address,
+	ethClient gasEstimator,
+) (SimulationResult, error) {
+	claim, err := b.BuildClaim(ctx, earner)
+	if err != nil {
+		return SimulationResult{}, err
+	}
+
+	valid, err := b.reader.CheckClaim(&bind.CallOpts{Context: ctx}, claim)
+	if err != nil {
+		return SimulationResult{Claim: claim}, fmt.Errorf("error checking claim: %w", err)
+	}
+
+	result := SimulationResult{Claim: claim, Valid: valid}
+	if ethClient == nil {
+		return result, nil
+	}
+
+	parsedABI, err := contractIRewardsCoordinator.ContractIRewardsCoordinatorMetaData.GetAbi()
+	if err != nil {
+		return result, fmt.Errorf("error parsing RewardsCoordinator ABI: %w", err)
+	}
+	calldata, err := parsedABI.Pack("processClaim", claim, recipient)
+	if err != nil {
+		return result, fmt.Errorf("error encoding ProcessClaim calldata: %w", err)
+	}
+
+	gas, err := ethClient.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &coordinatorAddress, Data: calldata})
+	if err != nil {
+		return result, fmt.Errorf("error estimating ProcessClaim gas: %w", err)
+	}
+	result.GasLimit = gas
+	return result, nil
+}
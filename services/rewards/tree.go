@@ -0,0 +1,54 @@
+package rewards
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/elcontracts/rewardsmerkle"
+	contractIRewardsCoordinator "github.com/Layr-Labs/eigensdk-go/contracts/bindings/IRewardsCoordinator"
+)
+
+// ErrNoClaimableRoot is returned when a DistributionRoot is requested but
+// none of the roots posted to the RewardsCoordinator are currently claimable.
+var ErrNoClaimableRoot = errors.New("no claimable distribution root found")
+
+// Earning is a single (token, cumulativeEarnings) pair for one earner, as
+// read from an off-chain rewards calculation.
+type Earning = rewardsmerkle.Earning
+
+// EarnerLeaves maps an earner address to the leaves of its per-earner token
+// tree, sorted by token address as required on-chain.
+type EarnerLeaves map[common.Address][]Earning
+
+// EarnerTree is the full two-level tree for a DistributionRoot: an earner
+// tree whose leaves commit to each earner's own token tree root. It is a
+// thin wrapper around rewardsmerkle.ClaimTree so that callers of this
+// package don't need to import rewardsmerkle directly.
+type EarnerTree struct {
+	inner *rewardsmerkle.ClaimTree
+}
+
+// BuildEarnerTree constructs the two-level Merkle tree described by entries,
+// keyed by earner address.
+func BuildEarnerTree(entries EarnerLeaves) (*EarnerTree, error) {
+	inner, err := rewardsmerkle.BuildClaimTree(entries)
+	if err != nil {
+		return nil, err
+	}
+	return &EarnerTree{inner: inner}, nil
+}
+
+// Root returns the earner tree root, i.e. the DistributionRoot that gets
+// posted via SubmitRoot.
+func (t *EarnerTree) Root() [32]byte {
+	return t.inner.Root()
+}
+
+// ProveEarner returns the EarnerIndex/EarnerTreeProof/EarnerLeaf and the
+// TokenIndices/TokenTreeProofs/TokenLeaves for every token the earner has
+// earnings in, ready to be embedded in an
+// IRewardsCoordinatorRewardsMerkleClaim once RootIndex is filled in.
+func (t *EarnerTree) ProveEarner(earner common.Address) (contractIRewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, error) {
+	return t.inner.ProveEarner(earner)
+}
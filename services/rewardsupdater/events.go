@@ -0,0 +1,56 @@
+package rewardsupdater
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EventType identifies which stage of a rewards cycle an Event describes.
+type EventType int
+
+const (
+	// EventRootComputed fires once the Merkle tree for a cycle has been
+	// built and its root computed, before anything is broadcast.
+	EventRootComputed EventType = iota
+	// EventRootSubmitted fires after SubmitRoot's transaction is
+	// confirmed.
+	EventRootSubmitted
+	// EventRootActivated fires once the wait for the activation delay has
+	// elapsed and the root is live for claims.
+	EventRootActivated
+	// EventConflictDetected fires when the dry-run replay finds an entry
+	// whose computed cumulative earnings would be less than what's
+	// already recorded on-chain as claimed - a monotonicity violation
+	// that would make SubmitRoot's claims unredeemable.
+	EventConflictDetected
+)
+
+// Event is a structured notification describing one step of a rewards
+// cycle, meant to be logged or alerted on rather than parsed.
+type Event struct {
+	Type                           EventType
+	RewardsCalculationEndTimestamp uint32
+	Root                           [32]byte
+	RootIndex                      uint32
+
+	// Earner, Token, ComputedAmount, and OnChainClaimed are only set for
+	// EventConflictDetected.
+	Earner         common.Address
+	Token          common.Address
+	ComputedAmount *big.Int
+	OnChainClaimed *big.Int
+
+	ActivatesAt time.Time
+}
+
+// EventHandler receives Events as a rewards cycle progresses. A nil
+// handler is valid and simply discards events.
+type EventHandler func(Event)
+
+func (u *Updater) emit(ev Event) {
+	if u.onEvent != nil {
+		u.onEvent(ev)
+	}
+}
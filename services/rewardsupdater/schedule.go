@@ -0,0 +1,24 @@
+package rewardsupdater
+
+import "time"
+
+// Schedule decides when the next rewards calculation/submission cycle
+// should run, given the time the previous one ran (or the zero time, for
+// the very first call). It mirrors chainio/rewards's Schedule so callers
+// don't need a cron library dependency for the common fixed-interval case.
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+// IntervalSchedule returns Interval after the time it is given. Run passes
+// the zero time first, so the first cycle starts at once, and afterwards
+// the time the previous cycle finished, so each cycle starts Interval after
+// the previous one ended rather than on a fixed wall-clock boundary.
+type IntervalSchedule struct {
+	Interval time.Duration
+}
+
+// Next implements Schedule.
+func (s IntervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(s.Interval)
+}
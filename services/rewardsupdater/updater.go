@@ -0,0 +1,278 @@
+// Package rewardsupdater automates the recurring side of being the
+// RewardsCoordinator's rewardsUpdater: on a caller-supplied Schedule, it
+// asks a pluggable RewardsCalculator for the cumulative earnings per
+// earner and token as of CALCULATION_INTERVAL_SECONDS past the current
+// rewards calculation end timestamp (the Schedule itself is not aligned to
+// that interval), builds the
+// Merkle tree via chainio/rewards/merkle, dry-run verifies the result
+// against on-chain cumulativeClaimed to catch monotonicity violations,
+// and submits the root with EIP-1559 fee bumping - waiting out the
+// previous root's activation delay before starting the next cycle.
+package rewardsupdater
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	merkle "github.com/Layr-Labs/eigensdk-go/chainio/rewards/merkle"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// Earning is a convenience alias for the underlying builder package's
+// (token, cumulativeEarnings) pair.
+type Earning = merkle.Earning
+
+// RewardsCalculator computes every earner's cumulative earnings as of
+// endTimestamp. Implementations are expected to be backed by the indexer
+// in chainio/rewards/indexer plus whatever off-chain reward logic the AVS
+// uses; this package only cares about the resulting tuples.
+type RewardsCalculator interface {
+	Calculate(ctx context.Context, endTimestamp uint32) (map[common.Address][]Earning, error)
+}
+
+// rewardsCoordinatorCaller is the subset of read methods this package
+// needs from the RewardsCoordinator.
+type rewardsCoordinatorCaller interface {
+	CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error)
+	CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error)
+	ActivationDelay(opts *bind.CallOpts) (uint32, error)
+	CumulativeClaimed(opts *bind.CallOpts, claimer common.Address, token common.Address) (*big.Int, error)
+}
+
+// rewardsCoordinatorTransactor is the subset of write methods this
+// package needs from the RewardsCoordinator.
+type rewardsCoordinatorTransactor interface {
+	SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error)
+}
+
+// Config controls an Updater's retry and fee-bumping behavior.
+type Config struct {
+	MaxRetries         int
+	FeeBumpNumerator   int64
+	FeeBumpDenominator int64
+	RetryBackoff       time.Duration
+	// ActivationPollInterval controls how finely Run sleeps while waiting
+	// out a submitted root's activation delay.
+	ActivationPollInterval time.Duration
+}
+
+// Updater runs the recurring SubmitRoot cadence for one RewardsCoordinator.
+type Updater struct {
+	calculator RewardsCalculator
+	caller     rewardsCoordinatorCaller
+	transactor rewardsCoordinatorTransactor
+	txOpts     func(ctx context.Context) (*bind.TransactOpts, error)
+	waitMined  func(ctx context.Context, tx *types.Transaction) error
+	config     Config
+	onEvent    EventHandler
+	logger     logging.Logger
+}
+
+// NewUpdater returns an Updater. txOpts supplies fresh transact options
+// (nonce, gas fee caps) for each SubmitRoot attempt; waitMined blocks
+// until tx is included or errors (e.g. on context cancellation or chain
+// reorg past a retry limit).
+func NewUpdater(
+	calculator RewardsCalculator,
+	caller rewardsCoordinatorCaller,
+	transactor rewardsCoordinatorTransactor,
+	txOpts func(ctx context.Context) (*bind.TransactOpts, error),
+	waitMined func(ctx context.Context, tx *types.Transaction) error,
+	config Config,
+	onEvent EventHandler,
+	logger logging.Logger,
+) *Updater {
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	}
+	if config.FeeBumpNumerator == 0 {
+		config.FeeBumpNumerator = 11
+	}
+	if config.FeeBumpDenominator == 0 {
+		config.FeeBumpDenominator = 10
+	}
+	if config.ActivationPollInterval == 0 {
+		config.ActivationPollInterval = 30 * time.Second
+	}
+	return &Updater{
+		calculator: calculator,
+		caller:     caller,
+		transactor: transactor,
+		txOpts:     txOpts,
+		waitMined:  waitMined,
+		config:     config,
+		onEvent:    onEvent,
+		logger:     logger,
+	}
+}
+
+// Run drives the recurring cycle: compute, dry-run verify, submit, wait
+// for activation, then sleep until schedule's next tick. It runs until
+// ctx is canceled.
+func (u *Updater) Run(ctx context.Context, schedule Schedule) error {
+	next := schedule.Next(time.Time{})
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(next)):
+		}
+
+		if err := u.RunOnce(ctx); err != nil {
+			u.logger.Error("rewards update cycle failed", "err", err)
+		}
+
+		next = schedule.Next(time.Now())
+	}
+}
+
+// RunOnce performs a single compute-verify-submit-wait cycle.
+func (u *Updater) RunOnce(ctx context.Context) error {
+	prevEnd, err := u.caller.CurrRewardsCalculationEndTimestamp(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("error reading current rewards calculation end timestamp: %w", err)
+	}
+	interval, err := u.caller.CALCULATIONINTERVALSECONDS(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("error reading calculation interval: %w", err)
+	}
+	endTimestamp := prevEnd + interval
+
+	entries, err := u.calculator.Calculate(ctx, endTimestamp)
+	if err != nil {
+		return fmt.Errorf("error computing rewards for timestamp %d: %w", endTimestamp, err)
+	}
+
+	builder, err := merkle.NewBuilder(endTimestamp, entries)
+	if err != nil {
+		return fmt.Errorf("error building merkle tree for timestamp %d: %w", endTimestamp, err)
+	}
+	root := builder.ComputeDistributionRoot()
+	u.emit(Event{Type: EventRootComputed, RewardsCalculationEndTimestamp: endTimestamp, Root: root})
+
+	if err := u.checkMonotonicity(ctx, entries, endTimestamp); err != nil {
+		return err
+	}
+
+	delay, err := u.caller.ActivationDelay(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("error reading activation delay: %w", err)
+	}
+
+	if err := u.submitWithRetry(ctx, root, endTimestamp); err != nil {
+		return fmt.Errorf("error submitting root for timestamp %d: %w", endTimestamp, err)
+	}
+	u.emit(Event{Type: EventRootSubmitted, RewardsCalculationEndTimestamp: endTimestamp, Root: root})
+
+	activatesAt := time.Now().Add(time.Duration(delay) * time.Second)
+	u.waitForActivation(ctx, activatesAt)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	u.emit(Event{Type: EventRootActivated, RewardsCalculationEndTimestamp: endTimestamp, Root: root, ActivatesAt: activatesAt})
+
+	return nil
+}
+
+// checkMonotonicity dry-run replays entries against on-chain
+// cumulativeClaimed, emitting EventConflictDetected (and erroring) for any
+// earner/token whose newly computed cumulative amount would be less than
+// what's already been claimed - a root that would never happen in a
+// correct calculation, but one a buggy calculator could otherwise submit.
+func (u *Updater) checkMonotonicity(ctx context.Context, entries map[common.Address][]Earning, endTimestamp uint32) error {
+	for earner, earnings := range entries {
+		for _, e := range earnings {
+			claimed, err := u.caller.CumulativeClaimed(&bind.CallOpts{Context: ctx}, earner, e.Token)
+			if err != nil {
+				return fmt.Errorf("error reading cumulativeClaimed for earner %s token %s: %w", earner, e.Token, err)
+			}
+			if e.CumulativeEarnings.Cmp(claimed) < 0 {
+				u.emit(Event{
+					Type:                           EventConflictDetected,
+					RewardsCalculationEndTimestamp: endTimestamp,
+					Earner:                         earner,
+					Token:                          e.Token,
+					ComputedAmount:                 e.CumulativeEarnings,
+					OnChainClaimed:                 claimed,
+				})
+				return fmt.Errorf(
+					"monotonicity violation: earner %s token %s computed cumulative earnings %s is less than already-claimed %s",
+					earner, e.Token, e.CumulativeEarnings, claimed,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func (u *Updater) submitWithRetry(ctx context.Context, root [32]byte, endTimestamp uint32) error {
+	var lastErr error
+	for attempt := 0; attempt <= u.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(u.config.RetryBackoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		opts, err := u.txOpts(ctx)
+		if err != nil {
+			lastErr = fmt.Errorf("error building transact opts: %w", err)
+			continue
+		}
+		if attempt > 0 {
+			bumpFeeFields(opts, u.config.FeeBumpNumerator, u.config.FeeBumpDenominator)
+		}
+
+		tx, err := u.transactor.SubmitRoot(opts, root, endTimestamp)
+		if err != nil {
+			lastErr = fmt.Errorf("error sending submitRoot: %w", err)
+			continue
+		}
+		if err := u.waitMined(ctx, tx); err != nil {
+			lastErr = fmt.Errorf("error waiting for submitRoot to be mined: %w", err)
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("exhausted %d retries: %w", u.config.MaxRetries, lastErr)
+}
+
+// bumpFeeFields scales opts' EIP-1559 fee fields by numerator/denominator
+// in place, for use on a retry after a stuck or dropped transaction.
+func bumpFeeFields(opts *bind.TransactOpts, numerator, denominator int64) {
+	if opts.GasFeeCap != nil {
+		opts.GasFeeCap = new(big.Int).Div(new(big.Int).Mul(opts.GasFeeCap, big.NewInt(numerator)), big.NewInt(denominator))
+	}
+	if opts.GasTipCap != nil {
+		opts.GasTipCap = new(big.Int).Div(new(big.Int).Mul(opts.GasTipCap, big.NewInt(numerator)), big.NewInt(denominator))
+	}
+}
+
+// waitForActivation sleeps until activatesAt, polling at
+// ActivationPollInterval so a long wait can still observe ctx
+// cancellation promptly.
+func (u *Updater) waitForActivation(ctx context.Context, activatesAt time.Time) {
+	for {
+		remaining := time.Until(activatesAt)
+		if remaining <= 0 {
+			return
+		}
+		wait := remaining
+		if wait > u.config.ActivationPollInterval {
+			wait = u.config.ActivationPollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}